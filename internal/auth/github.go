@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	"strconv"
+	"strings"
+)
+
+type GitHubProvider struct {
+	name         string
+	displayName  string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	endpoint     oauth2.Endpoint
+	apiURL       string
+}
+
+// NewGitHubProvider creates a provider for github.com, or for a GitHub Enterprise Server when an url is configured
+func NewGitHubProvider(c *config.AuthProvider) (*GitHubProvider, error) {
+	defaultScopes := []string{"read:user", "user:email", "read:org"}
+
+	endpoint := github.Endpoint
+	apiURL := "https://api.github.com"
+
+	if c.URL != "" {
+		baseURL := strings.TrimSuffix(c.URL, "/")
+		endpoint = oauth2.Endpoint{
+			AuthURL:  baseURL + "/login/oauth/authorize",
+			TokenURL: baseURL + "/login/oauth/access_token",
+		}
+		apiURL = baseURL + "/api/v3"
+	}
+
+	displayName := c.DisplayName
+	if displayName == "" {
+		displayName = "GitHub"
+	}
+
+	return &GitHubProvider{
+		name:         c.Name,
+		displayName:  displayName,
+		clientID:     c.ClientID,
+		clientSecret: c.ClientSecret,
+		scopes:       append(defaultScopes, c.Scopes...),
+		endpoint:     endpoint,
+		apiURL:       apiURL,
+	}, nil
+}
+
+func (p *GitHubProvider) Name() string {
+	return p.name
+}
+
+func (p *GitHubProvider) DisplayName() string {
+	return p.displayName
+}
+
+func (p *GitHubProvider) GetLoginURL(redirectURI, state string) string {
+	oauth2Config := newOAuth2Config(p.endpoint, p.clientID, p.clientSecret, redirectURI, p.scopes)
+	return oauth2Config.AuthCodeURL(state)
+}
+
+func (p *GitHubProvider) Exchange(redirectURI, code string) (*User, error) {
+	ctx := context.Background()
+	oauth2Config := newOAuth2Config(p.endpoint, p.clientID, p.clientSecret, redirectURI, p.scopes)
+
+	oauth2Token, err := oauth2Config.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	client := oauth2Config.Client(ctx, oauth2Token)
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+
+	if err := fetchJSON(ctx, client, p.apiURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("failed to fetch github user: %v", err)
+	}
+
+	emails, err := fetchAllJSON[struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}](ctx, client, p.apiURL+"/user/emails")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch github user emails: %v", err)
+	}
+
+	orgs, err := fetchAllJSON[struct {
+		Login string `json:"login"`
+	}](ctx, client, p.apiURL+"/user/orgs")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch github user orgs: %v", err)
+	}
+
+	teams, err := fetchAllJSON[struct {
+		Slug         string `json:"slug"`
+		Organization struct {
+			Login string `json:"login"`
+		} `json:"organization"`
+	}](ctx, client, p.apiURL+"/user/teams")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch github user teams: %v", err)
+	}
+
+	var email string
+	var verifiedEmails = []string{}
+	for _, e := range emails {
+		if !e.Verified {
+			continue
+		}
+		verifiedEmails = append(verifiedEmails, e.Email)
+		if e.Primary {
+			email = e.Email
+		}
+	}
+
+	if email == "" {
+		return nil, fmt.Errorf("github user %s has no verified primary email address", user.Login)
+	}
+
+	var orgNames = []string{}
+	for _, o := range orgs {
+		orgNames = append(orgNames, o.Login)
+	}
+
+	var teamNames = []string{}
+	for _, t := range teams {
+		teamNames = append(teamNames, fmt.Sprintf("%s/%s", t.Organization.Login, t.Slug))
+	}
+
+	return &User{
+		ID:   strconv.FormatInt(user.ID, 10),
+		Name: email,
+		Attr: map[string]interface{}{
+			"email":    email,
+			"domain":   emailDomain(email),
+			"username": user.Login,
+			"emails":   verifiedEmails,
+			"orgs":     orgNames,
+			"teams":    teamNames,
+		},
+	}, nil
+}
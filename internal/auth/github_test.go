@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGitHubProvider_Exchange(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "abc", r.Form.Get("code"))
+		writeJSON(w, map[string]interface{}{"access_token": "token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		writeJSON(w, map[string]interface{}{"id": 1234, "login": "jdoe"})
+	})
+	mux.HandleFunc("GET /api/v3/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{
+			{"email": "john@example.com", "primary": true, "verified": true},
+			{"email": "jdoe@acme.com", "primary": false, "verified": true},
+			{"email": "jdoe@unverified.com", "primary": false, "verified": false},
+		})
+	})
+	mux.HandleFunc("GET /api/v3/user/orgs", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{{"login": "acme"}})
+	})
+	mux.HandleFunc("GET /api/v3/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{{"slug": "devops", "organization": map[string]interface{}{"login": "acme"}}})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewGitHubProvider(&config.AuthProvider{Name: "github", ClientID: "id", ClientSecret: "secret", URL: server.URL})
+	require.NoError(t, err)
+
+	user, err := provider.Exchange("http://localhost/a/callback", "abc")
+	require.NoError(t, err)
+
+	require.Equal(t, "1234", user.ID)
+	require.Equal(t, "john@example.com", user.Name)
+	require.Equal(t, "example.com", user.Attr["domain"])
+	require.Equal(t, "jdoe", user.Attr["username"])
+	require.Equal(t, []string{"john@example.com", "jdoe@acme.com"}, user.Attr["emails"])
+	require.Equal(t, []string{"acme"}, user.Attr["orgs"])
+	require.Equal(t, []string{"acme/devops"}, user.Attr["teams"])
+
+	evaluator, err := bexpr.CreateEvaluator(`orgs contains "acme" and teams contains "acme/devops"`)
+	require.NoError(t, err)
+
+	result, err := evaluator.Evaluate(user.Attr)
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestGitHubProvider_ExchangeWithoutVerifiedEmail(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"access_token": "token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"id": 1234, "login": "jdoe"})
+	})
+	mux.HandleFunc("GET /api/v3/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{{"email": "john@example.com", "primary": true, "verified": false}})
+	})
+	mux.HandleFunc("GET /api/v3/user/orgs", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{})
+	})
+	mux.HandleFunc("GET /api/v3/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewGitHubProvider(&config.AuthProvider{Name: "github", URL: server.URL})
+	require.NoError(t, err)
+
+	_, err = provider.Exchange("http://localhost/a/callback", "abc")
+	require.Error(t, err)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
+	"golang.org/x/oauth2"
+	"strconv"
+	"strings"
+)
+
+type GitLabProvider struct {
+	name         string
+	displayName  string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	endpoint     oauth2.Endpoint
+	apiURL       string
+}
+
+// NewGitLabProvider creates a provider for gitlab.com, or for a self-managed GitLab instance when an url is configured
+func NewGitLabProvider(c *config.AuthProvider) (*GitLabProvider, error) {
+	defaultScopes := []string{"read_user", "read_api"}
+
+	baseURL := "https://gitlab.com"
+	if c.URL != "" {
+		baseURL = strings.TrimSuffix(c.URL, "/")
+	}
+
+	displayName := c.DisplayName
+	if displayName == "" {
+		displayName = "GitLab"
+	}
+
+	return &GitLabProvider{
+		name:         c.Name,
+		displayName:  displayName,
+		clientID:     c.ClientID,
+		clientSecret: c.ClientSecret,
+		scopes:       append(defaultScopes, c.Scopes...),
+		endpoint: oauth2.Endpoint{
+			AuthURL:  baseURL + "/oauth/authorize",
+			TokenURL: baseURL + "/oauth/token",
+		},
+		apiURL: baseURL + "/api/v4",
+	}, nil
+}
+
+func (p *GitLabProvider) Name() string {
+	return p.name
+}
+
+func (p *GitLabProvider) DisplayName() string {
+	return p.displayName
+}
+
+func (p *GitLabProvider) GetLoginURL(redirectURI, state string) string {
+	oauth2Config := newOAuth2Config(p.endpoint, p.clientID, p.clientSecret, redirectURI, p.scopes)
+	return oauth2Config.AuthCodeURL(state)
+}
+
+func (p *GitLabProvider) Exchange(redirectURI, code string) (*User, error) {
+	ctx := context.Background()
+	oauth2Config := newOAuth2Config(p.endpoint, p.clientID, p.clientSecret, redirectURI, p.scopes)
+
+	oauth2Token, err := oauth2Config.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	client := oauth2Config.Client(ctx, oauth2Token)
+
+	var user struct {
+		ID          int64  `json:"id"`
+		Username    string `json:"username"`
+		Email       string `json:"email"`
+		ConfirmedAt string `json:"confirmed_at"`
+	}
+
+	if err := fetchJSON(ctx, client, p.apiURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("failed to fetch gitlab user: %v", err)
+	}
+
+	if user.Email == "" || user.ConfirmedAt == "" {
+		return nil, fmt.Errorf("gitlab user %s has no confirmed email address", user.Username)
+	}
+
+	emails, err := fetchAllJSON[struct {
+		Email       string `json:"email"`
+		ConfirmedAt string `json:"confirmed_at"`
+	}](ctx, client, p.apiURL+"/user/emails")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gitlab user emails: %v", err)
+	}
+
+	groups, err := fetchAllJSON[struct {
+		FullPath string `json:"full_path"`
+	}](ctx, client, p.apiURL+"/groups?min_access_level=10")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gitlab user groups: %v", err)
+	}
+
+	var verifiedEmails = []string{user.Email}
+	for _, e := range emails {
+		if e.ConfirmedAt != "" && e.Email != user.Email {
+			verifiedEmails = append(verifiedEmails, e.Email)
+		}
+	}
+
+	// top-level groups are the equivalent of GitHub organizations, subgroups of teams
+	var orgNames = []string{}
+	var groupNames = []string{}
+	for _, g := range groups {
+		groupNames = append(groupNames, g.FullPath)
+		if !strings.Contains(g.FullPath, "/") {
+			orgNames = append(orgNames, g.FullPath)
+		}
+	}
+
+	return &User{
+		ID:   strconv.FormatInt(user.ID, 10),
+		Name: user.Email,
+		Attr: map[string]interface{}{
+			"email":    user.Email,
+			"domain":   emailDomain(user.Email),
+			"username": user.Username,
+			"emails":   verifiedEmails,
+			"orgs":     orgNames,
+			"groups":   groupNames,
+		},
+	}, nil
+}
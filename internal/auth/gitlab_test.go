@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGitLabProvider_Exchange(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"access_token": "token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("GET /api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		writeJSON(w, map[string]interface{}{"id": 42, "username": "jdoe", "email": "john@example.com", "confirmed_at": "2024-01-01T00:00:00Z"})
+	})
+	mux.HandleFunc("GET /api/v4/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{
+			{"email": "jdoe@acme.com", "confirmed_at": "2024-01-01T00:00:00Z"},
+			{"email": "jdoe@unverified.com", "confirmed_at": nil},
+		})
+	})
+	mux.HandleFunc("GET /api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "10", r.URL.Query().Get("min_access_level"))
+		writeJSON(w, []map[string]interface{}{{"full_path": "acme"}, {"full_path": "acme/devops"}})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewGitLabProvider(&config.AuthProvider{Name: "gitlab", ClientID: "id", ClientSecret: "secret", URL: server.URL})
+	require.NoError(t, err)
+
+	user, err := provider.Exchange("http://localhost/a/callback", "abc")
+	require.NoError(t, err)
+
+	require.Equal(t, "42", user.ID)
+	require.Equal(t, "john@example.com", user.Name)
+	require.Equal(t, "jdoe", user.Attr["username"])
+	require.Equal(t, []string{"john@example.com", "jdoe@acme.com"}, user.Attr["emails"])
+	require.Equal(t, []string{"acme"}, user.Attr["orgs"])
+	require.Equal(t, []string{"acme", "acme/devops"}, user.Attr["groups"])
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+)
+
+const maxPages = 10
+
+func newOAuth2Config(endpoint oauth2.Endpoint, clientID, clientSecret, redirectURI string, scopes []string) oauth2.Config {
+	return oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURI,
+		Endpoint:     endpoint,
+		Scopes:       scopes,
+	}
+}
+
+func fetchJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// fetchAllJSON fetches all pages of a paginated list endpoint, limited to maxPages pages of 100 items
+func fetchAllJSON[T any](ctx context.Context, client *http.Client, url string) ([]T, error) {
+	var result []T
+
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+
+	for page := 1; page <= maxPages; page++ {
+		var items []T
+		if err := fetchJSON(ctx, client, fmt.Sprintf("%s%sper_page=100&page=%d", url, separator, page), &items); err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+		if len(items) < 100 {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func emailDomain(email string) string {
+	if i := strings.LastIndex(email, "@"); i != -1 {
+		return email[i+1:]
+	}
+	return ""
+}
@@ -11,6 +11,8 @@ import (
 )
 
 type OIDCProvider struct {
+	name         string
+	displayName  string
 	clientID     string
 	clientSecret string
 	scopes       []string
@@ -27,7 +29,14 @@ func NewOIDCProvider(c *config.AuthProvider) (*OIDCProvider, error) {
 
 	verifier := provider.Verifier(&oidc.Config{ClientID: c.ClientID, SkipClientIDCheck: c.ClientID == ""})
 
+	displayName := c.DisplayName
+	if displayName == "" {
+		displayName = "OpenID"
+	}
+
 	return &OIDCProvider{
+		name:         c.Name,
+		displayName:  displayName,
 		clientID:     c.ClientID,
 		clientSecret: c.ClientSecret,
 		scopes:       append(defaultScopes, c.Scopes...),
@@ -36,6 +45,14 @@ func NewOIDCProvider(c *config.AuthProvider) (*OIDCProvider, error) {
 	}, nil
 }
 
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+func (p *OIDCProvider) DisplayName() string {
+	return p.displayName
+}
+
 func (p *OIDCProvider) GetLoginURL(redirectURI, state string) string {
 	oauth2Config := oauth2.Config{
 		ClientID:     p.clientID,
@@ -1,6 +1,13 @@
 package auth
 
+import (
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
+)
+
 type Provider interface {
+	Name() string
+	DisplayName() string
 	GetLoginURL(redirectURI, state string) string
 	Exchange(redirectURI, code string) (*User, error)
 }
@@ -10,3 +17,36 @@ type User struct {
 	Name string
 	Attr map[string]interface{}
 }
+
+func NewProvider(c *config.AuthProvider) (Provider, error) {
+	switch c.Type {
+	case "", config.AuthProviderTypeOIDC:
+		return NewOIDCProvider(c)
+	case config.AuthProviderTypeGitHub:
+		return NewGitHubProvider(c)
+	case config.AuthProviderTypeGitLab:
+		return NewGitLabProvider(c)
+	default:
+		return nil, fmt.Errorf("unsupported auth provider type '%s'", c.Type)
+	}
+}
+
+// Providers is the ordered list of configured auth providers
+type Providers []Provider
+
+func (p Providers) Get(name string) Provider {
+	for _, i := range p {
+		if i.Name() == name {
+			return i
+		}
+	}
+	return nil
+}
+
+func (p Providers) Names() []string {
+	var names = []string{}
+	for _, i := range p {
+		names = append(names, i.Name())
+	}
+	return names
+}
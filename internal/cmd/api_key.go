@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	str2dur "github.com/xhit/go-str2duration/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+func apiKeysCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:     "api-keys",
+		Aliases: []string{"api-key"},
+		Short:   "Manage your ionscale api keys",
+	}
+
+	command.AddCommand(createApiKeyCommand())
+	command.AddCommand(deleteApiKeyCommand())
+	command.AddCommand(listApiKeysCommand())
+
+	return command
+}
+
+func createApiKeyCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "create",
+		Short:        "Creates a new api key for the current user",
+		SilenceUsage: true,
+	})
+
+	var expiry string
+
+	command.Flags().StringVar(&expiry, "expiry", "90d", "Human-readable expiration of the key")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		var expiryDur *durationpb.Duration
+
+		if expiry != "" && expiry != "none" {
+			duration, err := str2dur.ParseDuration(expiry)
+			if err != nil {
+				return err
+			}
+			expiryDur = durationpb.New(duration)
+		}
+
+		resp, err := tc.Client().CreateApiKey(cmd.Context(), connect.NewRequest(&api.CreateApiKeyRequest{Expiry: expiryDur}))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("")
+		fmt.Println("Generated new api key")
+		fmt.Println("Be sure to copy your new key below. It won't be shown in full again.")
+		fmt.Println("")
+		fmt.Printf("  %s\n", resp.Msg.Value)
+		fmt.Println("")
+
+		return nil
+	}
+
+	return command
+}
+
+func deleteApiKeyCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "delete",
+		Short:        "Delete a specified api key",
+		SilenceUsage: true,
+	})
+
+	var apiKeyId uint64
+
+	command.Flags().Uint64Var(&apiKeyId, "id", 0, "Api Key ID")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.DeleteApiKeyRequest{ApiKeyId: apiKeyId}
+		if _, err := tc.Client().DeleteApiKey(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Api key deleted.")
+
+		return nil
+	}
+
+	return command
+}
+
+func listApiKeysCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "list",
+		Short:        "List the api keys of the current user",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().ListApiKeys(cmd.Context(), connect.NewRequest(&api.ListApiKeysRequest{}))
+		if err != nil {
+			return err
+		}
+
+		tbl := table.New("ID", "KEY", "TAILNET", "EXPIRED", "EXPIRES_AT")
+		for _, apiKey := range resp.Msg.ApiKeys {
+			var expired = false
+			var expiresAt = "never"
+			if apiKey.ExpiresAt != nil {
+				expiresAt = apiKey.ExpiresAt.AsTime().Local().Format("2006-01-02 15:04:05")
+				expired = time.Now().After(apiKey.ExpiresAt.AsTime())
+			}
+			tbl.AddRow(apiKey.Id, fmt.Sprintf("%s...", apiKey.Key), apiKey.Tailnet.Name, expired, expiresAt)
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
@@ -37,11 +37,13 @@ func createAuthkeysCommand() *cobra.Command {
 	var preAuthorized bool
 	var tags []string
 	var expiry string
+	var description string
 
 	command.Flags().BoolVar(&ephemeral, "ephemeral", false, "When enabled, machines authenticated by this key will be automatically removed after going offline.")
 	command.Flags().StringSliceVar(&tags, "tag", []string{}, "Machines authenticated by this key will be automatically tagged with these tags")
 	command.Flags().StringVar(&expiry, "expiry", "180d", "Human-readable expiration of the key")
 	command.Flags().BoolVar(&preAuthorized, "pre-authorized", false, "Generate an auth key which is pre-authorized.")
+	command.Flags().StringVar(&description, "description", "", "A short description of the auth key")
 
 	command.RunE = func(cmd *cobra.Command, args []string) error {
 		var expiryDur *durationpb.Duration
@@ -60,6 +62,7 @@ func createAuthkeysCommand() *cobra.Command {
 			PreAuthorized: preAuthorized,
 			Tags:          tags,
 			Expiry:        expiryDur,
+			Description:   description,
 		}
 		resp, err := tc.Client().CreateAuthKey(cmd.Context(), connect.NewRequest(req))
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"github.com/bufbuild/connect-go"
 	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/nleeper/goment"
+	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 	"tailscale.com/tailcfg"
@@ -17,6 +19,7 @@ func systemCommand() *cobra.Command {
 	}
 
 	command.AddCommand(getDefaultDERPMap())
+	command.AddCommand(getDefaultDERPHealth())
 
 	return command
 }
@@ -68,3 +71,78 @@ func getDefaultDERPMap() *cobra.Command {
 
 	return command
 }
+
+func getDefaultDERPHealth() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "get-derp-health",
+		Short:        "Get the health of the nodes in the default DERP Map",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetDERPHealth(cmd.Context(), connect.NewRequest(&api.GetDERPHealthRequest{}))
+		if err != nil {
+			return err
+		}
+
+		printDERPHealth(resp.Msg)
+
+		return nil
+	}
+
+	return command
+}
+
+func getDERPHealth() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "get-derp-health",
+		Short:        "Get the health of the nodes in the DERP Map",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetDERPHealth(cmd.Context(), connect.NewRequest(&api.GetDERPHealthRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		printDERPHealth(resp.Msg)
+
+		return nil
+	}
+
+	return command
+}
+
+func printDERPHealth(resp *api.GetDERPHealthResponse) {
+	if !resp.Enabled {
+		fmt.Println("DERP probing is not enabled.")
+		return
+	}
+
+	tbl := table.New("REGION", "NODE", "HOSTNAME", "STATUS", "LATENCY", "LAST_CHECKED", "ERROR")
+	for _, n := range resp.Nodes {
+		var status, latency, lastChecked = "Unknown", "N/A", "N/A"
+		if n.Probed {
+			status = "Unhealthy"
+			if n.Healthy {
+				status = "Healthy"
+			}
+
+			latency = fmt.Sprintf("%dms", n.LatencyMs)
+
+			mom, err := goment.New(n.LastChecked.AsTime())
+			if err == nil {
+				lastChecked = mom.FromNow()
+			}
+		}
+
+		errMsg := n.DerpError
+		if errMsg == "" {
+			errMsg = n.StunError
+		}
+
+		tbl.AddRow(fmt.Sprintf("%d/%s", n.RegionId, n.RegionCode), n.NodeName, n.HostName, status, latency, lastChecked, errMsg)
+	}
+	tbl.Print()
+}
@@ -4,12 +4,161 @@ import (
 	"fmt"
 	"github.com/bufbuild/connect-go"
 	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
+	"net/netip"
 	"os"
 	"strings"
 	"text/tabwriter"
 )
 
+func dnsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "dns",
+		Short: "Manage the DNS configuration of a tailnet",
+	}
+
+	command.AddCommand(dnsRecordsCommand())
+
+	return command
+}
+
+func dnsRecordsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:     "records",
+		Aliases: []string{"record"},
+		Short:   "Manage custom DNS records",
+	}
+
+	command.AddCommand(addDNSRecordCommand())
+	command.AddCommand(removeDNSRecordCommand())
+	command.AddCommand(listDNSRecordsCommand())
+
+	return command
+}
+
+func addDNSRecordCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "add",
+		Short:        "Add a custom DNS record to the specified tailnet",
+		SilenceUsage: true,
+	})
+
+	var name string
+	var recordType string
+	var value string
+
+	command.Flags().StringVar(&name, "name", "", "Fully qualified name of the record, e.g. db.internal")
+	command.Flags().StringVar(&recordType, "type", "", "Type of the record, A or AAAA; derived from the value when empty")
+	command.Flags().StringVar(&value, "value", "", "IP address the name resolves to")
+
+	_ = command.MarkFlagRequired("name")
+	_ = command.MarkFlagRequired("value")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetDNSConfig(cmd.Context(), connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		config := resp.Msg.Config
+		config.ExtraRecords = append(config.ExtraRecords, &api.DNSRecord{Name: name, Type: recordType, Value: value})
+
+		req := &api.SetDNSConfigRequest{TailnetId: tc.TailnetID(), Config: config}
+		if _, err := tc.Client().SetDNSConfig(cmd.Context(), connect.NewRequest(req)); err != nil {
+			return err
+		}
+
+		fmt.Println("DNS record added.")
+
+		return nil
+	}
+
+	return command
+}
+
+func removeDNSRecordCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "remove",
+		Short:        "Remove a custom DNS record from the specified tailnet",
+		SilenceUsage: true,
+	})
+
+	var name string
+	var value string
+
+	command.Flags().StringVar(&name, "name", "", "Name of the record to remove")
+	command.Flags().StringVar(&value, "value", "", "When set, only the record with this value is removed")
+
+	_ = command.MarkFlagRequired("name")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetDNSConfig(cmd.Context(), connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		config := resp.Msg.Config
+
+		var records []*api.DNSRecord
+		for _, r := range config.ExtraRecords {
+			if strings.EqualFold(strings.TrimSuffix(r.Name, "."), strings.TrimSuffix(name, ".")) && (value == "" || r.Value == value) {
+				continue
+			}
+			records = append(records, r)
+		}
+
+		if len(records) == len(config.ExtraRecords) {
+			return fmt.Errorf("no DNS record found with name '%s'", name)
+		}
+
+		config.ExtraRecords = records
+
+		req := &api.SetDNSConfigRequest{TailnetId: tc.TailnetID(), Config: config}
+		if _, err := tc.Client().SetDNSConfig(cmd.Context(), connect.NewRequest(req)); err != nil {
+			return err
+		}
+
+		fmt.Println("DNS record removed.")
+
+		return nil
+	}
+
+	return command
+}
+
+func listDNSRecordsCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "list",
+		Short:        "List the custom DNS records of the specified tailnet",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetDNSConfig(cmd.Context(), connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		tbl := table.New("NAME", "TYPE", "VALUE")
+		for _, r := range resp.Msg.Config.ExtraRecords {
+			recordType := r.Type
+			if recordType == "" {
+				recordType = "A"
+				if ip, err := netip.ParseAddr(r.Value); err == nil && ip.Is6() {
+					recordType = "AAAA"
+				}
+			}
+			tbl.AddRow(r.Name, recordType, r.Value)
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
+
 func getDNSConfigCommand() *cobra.Command {
 	command, tc := prepareCommand(true, &cobra.Command{
 		Use:          "get-dns",
@@ -46,14 +195,25 @@ func setDNSConfigCommand() *cobra.Command {
 	var httpsCerts bool
 	var overrideLocalDNS bool
 	var searchDomains []string
+	var dnsDomain string
 
 	command.Flags().StringSliceVarP(&nameservers, "nameserver", "", []string{}, "Machines on your network will use these nameservers to resolve DNS queries.")
 	command.Flags().BoolVarP(&magicDNS, "magic-dns", "", false, "Enable MagicDNS for the specified Tailnet")
 	command.Flags().BoolVarP(&httpsCerts, "https-certs", "", false, "Enable HTTPS Certificates for the specified Tailnet")
 	command.Flags().BoolVarP(&overrideLocalDNS, "override-local-dns", "", false, "When enabled, connected clients ignore local DNS settings and always use the nameservers specified for this Tailnet")
 	command.Flags().StringSliceVarP(&searchDomains, "search-domain", "", []string{}, "Custom DNS search domains.")
+	command.Flags().StringVar(&dnsDomain, "dns-domain", "", "Custom MagicDNS domain for the specified Tailnet, instead of a subdomain of the global MagicDNS suffix. Kept as is when not set.")
 
 	command.RunE = func(cmd *cobra.Command, args []string) error {
+		current, err := tc.Client().GetDNSConfig(cmd.Context(), connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		if !cmd.Flags().Changed("dns-domain") {
+			dnsDomain = current.Msg.Config.Domain
+		}
+
 		var globalNameservers []string
 		var routes = make(map[string]*api.Routes)
 
@@ -80,6 +240,8 @@ func setDNSConfigCommand() *cobra.Command {
 				Routes:           routes,
 				HttpsCerts:       httpsCerts,
 				SearchDomains:    searchDomains,
+				ExtraRecords:     current.Msg.Config.ExtraRecords,
+				Domain:           dnsDomain,
 			},
 		}
 		resp, err := tc.Client().SetDNSConfig(cmd.Context(), connect.NewRequest(&req))
@@ -141,4 +303,12 @@ func printDnsConfig(config *api.DNSConfig) {
 			fmt.Fprintf(w, "%s\t%s\t%s\n", "", t, "")
 		}
 	}
+
+	for i, r := range config.ExtraRecords {
+		if i == 0 {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", "Extra Records", r.Name, r.Value)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", "", r.Name, r.Value)
+		}
+	}
 }
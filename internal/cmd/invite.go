@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	str2dur "github.com/xhit/go-str2duration/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+func invitesCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:     "invites",
+		Aliases: []string{"invite"},
+		Short:   "Manage ionscale tailnet invites",
+	}
+
+	command.AddCommand(createInviteCommand())
+	command.AddCommand(deleteInviteCommand())
+	command.AddCommand(listInvitesCommand())
+
+	return command
+}
+
+func createInviteCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "create",
+		Short:        "Creates a new invite link for the specified tailnet",
+		SilenceUsage: true,
+	})
+
+	var expiry string
+	var maxUses int32
+	var email string
+	var role string
+
+	command.Flags().StringVar(&expiry, "expiry", "7d", "Human-readable expiration of the invite")
+	command.Flags().Int32Var(&maxUses, "max-uses", 1, "Number of users who can accept the invite, 0 means unlimited")
+	command.Flags().StringVar(&email, "email", "", "When set, only the user with this email can accept the invite")
+	command.Flags().StringVar(&role, "role", "member", "Role granted to users accepting the invite")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		var expiryDur *durationpb.Duration
+
+		if expiry != "" && expiry != "none" {
+			duration, err := str2dur.ParseDuration(expiry)
+			if err != nil {
+				return err
+			}
+			expiryDur = durationpb.New(duration)
+		}
+
+		req := &api.CreateInviteRequest{
+			TailnetId: tc.TailnetID(),
+			Expiry:    expiryDur,
+			MaxUses:   maxUses,
+			Email:     email,
+			Role:      role,
+		}
+		resp, err := tc.Client().CreateInvite(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("")
+		fmt.Println("Generated new invite link")
+		fmt.Println("Be sure to copy the link below. It won't be shown again.")
+		fmt.Println("")
+		fmt.Printf("  %s\n", resp.Msg.Url)
+		fmt.Println("")
+
+		return nil
+	}
+
+	return command
+}
+
+func deleteInviteCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "delete",
+		Short:        "Delete a specified invite",
+		SilenceUsage: true,
+	})
+
+	var inviteId uint64
+
+	command.Flags().Uint64Var(&inviteId, "id", 0, "Invite ID")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.DeleteInviteRequest{InviteId: inviteId}
+		if _, err := tc.Client().DeleteInvite(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Invite deleted.")
+
+		return nil
+	}
+
+	return command
+}
+
+func listInvitesCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "list",
+		Short:        "List all invites for a given tailnet",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := &api.ListInvitesRequest{TailnetId: tc.TailnetID()}
+		resp, err := tc.Client().ListInvites(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		tbl := table.New("ID", "KEY", "EMAIL", "ROLE", "USES", "EXPIRED", "EXPIRES_AT", "CREATED_BY")
+		for _, invite := range resp.Msg.Invites {
+			var expired = false
+			var expiresAt = "never"
+			if invite.ExpiresAt != nil {
+				expiresAt = invite.ExpiresAt.AsTime().Local().Format("2006-01-02 15:04:05")
+				expired = time.Now().After(invite.ExpiresAt.AsTime())
+			}
+
+			uses := fmt.Sprintf("%d", invite.Uses)
+			if invite.MaxUses != 0 {
+				uses = fmt.Sprintf("%d/%d", invite.Uses, invite.MaxUses)
+			}
+
+			tbl.AddRow(invite.Id, fmt.Sprintf("%s...", invite.Key), invite.Email, invite.Role, uses, expired, expiresAt, invite.CreatedBy)
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
@@ -33,7 +33,13 @@ func machineCommands() *cobra.Command {
 	command.AddCommand(disableExitNodeCommand())
 	command.AddCommand(disableMachineKeyExpiryCommand())
 	command.AddCommand(authorizeMachineCommand())
+	command.AddCommand(quarantineMachineCommand())
+	command.AddCommand(unquarantineMachineCommand())
 	command.AddCommand(setMachineNameCommand())
+	command.AddCommand(shareMachineCommand())
+	command.AddCommand(listMachineSharesCommand())
+	command.AddCommand(acceptMachineShareCommand())
+	command.AddCommand(revokeMachineShareCommand())
 
 	return command
 }
@@ -93,6 +99,9 @@ func getMachineCommand() *cobra.Command {
 		if !m.Authorized {
 			fmt.Fprintf(w, "%s\t%v\n", "Authorized", m.Authorized)
 		}
+		if m.Quarantined {
+			fmt.Fprintf(w, "%s\t%s\n", "Quarantined", m.QuarantineReason)
+		}
 		fmt.Fprintf(w, "%s\t%s\n", "Key expiry", expiresAt)
 
 		for i, t := range m.Tags {
@@ -255,6 +264,60 @@ func authorizeMachineCommand() *cobra.Command {
 	return command
 }
 
+func quarantineMachineCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "quarantine",
+		Short:        "Isolates a machine from all its peers, without removing it",
+		SilenceUsage: true,
+	})
+
+	var machineID uint64
+	var reason string
+	command.Flags().Uint64Var(&machineID, "machine-id", 0, "Machine ID.")
+	command.Flags().StringVar(&reason, "reason", "", "Reason shown on the machine.")
+
+	_ = command.MarkFlagRequired("machine-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.QuarantineMachineRequest{MachineId: machineID, Reason: reason}
+		if _, err := tc.Client().QuarantineMachine(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Machine quarantined.")
+
+		return nil
+	}
+
+	return command
+}
+
+func unquarantineMachineCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "unquarantine",
+		Short:        "Releases a machine from quarantine",
+		SilenceUsage: true,
+	})
+
+	var machineID uint64
+	command.Flags().Uint64Var(&machineID, "machine-id", 0, "Machine ID.")
+
+	_ = command.MarkFlagRequired("machine-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.UnquarantineMachineRequest{MachineId: machineID}
+		if _, err := tc.Client().UnquarantineMachine(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Machine released from quarantine.")
+
+		return nil
+	}
+
+	return command
+}
+
 func listMachinesCommand() *cobra.Command {
 	command, tc := prepareCommand(true, &cobra.Command{
 		Use:          "list",
@@ -510,6 +573,14 @@ func printMachinesRoutesResponse(msg *api.MachineRoutes) {
 		}
 	}
 
+	for i, t := range msg.PrimaryRoutes {
+		if i == 0 {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", "Primary routers", t.Route, t.Machine.Name)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", "", t.Route, t.Machine.Name)
+		}
+	}
+
 	if msg.AdvertisedExitNode {
 		if msg.EnabledExitNode {
 			fmt.Fprintf(w, "%s\t%s\n", "Exit node", "enabled")
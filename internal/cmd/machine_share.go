@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func shareMachineCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "share",
+		Short:        "Invites another tailnet to use a given machine",
+		SilenceUsage: true,
+	})
+
+	var machineID uint64
+	var tailnet string
+	command.Flags().Uint64Var(&machineID, "machine-id", 0, "Machine ID.")
+	command.Flags().StringVar(&tailnet, "with", "", "Name of the tailnet to share the machine with.")
+
+	_ = command.MarkFlagRequired("machine-id")
+	_ = command.MarkFlagRequired("with")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.ShareMachineRequest{MachineId: machineID, Tailnet: tailnet}
+		resp, err := tc.Client().ShareMachine(cmd.Context(), connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Machine shared, the invite with id %d is pending until accepted by an admin of tailnet %s.\n", resp.Msg.Share.Id, tailnet)
+
+		return nil
+	}
+
+	return command
+}
+
+func listMachineSharesCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "list-shares",
+		Short:        "List the machines shared with or by a tailnet",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.ListMachineSharesRequest{TailnetId: tc.TailnetID()}
+		resp, err := tc.Client().ListMachineShares(cmd.Context(), connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+
+		tbl := table.New("ID", "MACHINE", "FROM", "TO", "ACCEPTED")
+		for _, s := range resp.Msg.Shares {
+			tbl.AddRow(s.Id, s.Machine.Name, s.SourceTailnet.Name, s.Tailnet.Name, s.Accepted)
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
+
+func acceptMachineShareCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "accept-share",
+		Short:        "Accepts an invite to use a machine of another tailnet",
+		SilenceUsage: true,
+	})
+
+	var shareID uint64
+	command.Flags().Uint64Var(&shareID, "share-id", 0, "Share ID.")
+
+	_ = command.MarkFlagRequired("share-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.AcceptMachineShareRequest{ShareId: shareID}
+		if _, err := tc.Client().AcceptMachineShare(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Machine share accepted.")
+
+		return nil
+	}
+
+	return command
+}
+
+func revokeMachineShareCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "revoke-share",
+		Short:        "Revokes a machine share or a pending invite",
+		SilenceUsage: true,
+	})
+
+	var shareID uint64
+	command.Flags().Uint64Var(&shareID, "share-id", 0, "Share ID.")
+
+	_ = command.MarkFlagRequired("share-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.RevokeMachineShareRequest{ShareId: shareID}
+		if _, err := tc.Client().RevokeMachineShare(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Machine share revoked.")
+
+		return nil
+	}
+
+	return command
+}
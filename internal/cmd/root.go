@@ -12,11 +12,15 @@ func Command() *cobra.Command {
 	rootCmd.AddCommand(serverCommand())
 	rootCmd.AddCommand(versionCommand())
 	rootCmd.AddCommand(tailnetCommand())
+	rootCmd.AddCommand(dnsCommand())
 	rootCmd.AddCommand(authkeysCommand())
+	rootCmd.AddCommand(apiKeysCommand())
 	rootCmd.AddCommand(machineCommands())
 	rootCmd.AddCommand(userCommands())
+	rootCmd.AddCommand(invitesCommand())
 	rootCmd.AddCommand(systemCommand())
 	rootCmd.AddCommand(recorderCommand())
+	rootCmd.AddCommand(temporaryGrantsCommand())
 
 	return rootCmd
 }
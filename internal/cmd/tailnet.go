@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 	"os"
+	"slices"
 	"strings"
 	"tailscale.com/tailcfg"
 )
@@ -42,9 +43,14 @@ func tailnetCommand() *cobra.Command {
 	command.AddCommand(disableSSHCommand())
 	command.AddCommand(enableMachineAuthorizationCommand())
 	command.AddCommand(disableMachineAuthorizationCommand())
+	command.AddCommand(enableSCIMCommand())
+	command.AddCommand(disableSCIMCommand())
+	command.AddCommand(getAuthProvidersCommand())
+	command.AddCommand(setAuthProvidersCommand())
 	command.AddCommand(getDERPMap())
 	command.AddCommand(setDERPMap())
 	command.AddCommand(resetDERPMap())
+	command.AddCommand(getDERPHealth())
 
 	return command
 }
@@ -85,10 +91,12 @@ func createTailnetsCommand() *cobra.Command {
 	var name string
 	var domain string
 	var email string
+	var dnsDomain string
 
 	command.Flags().StringVarP(&name, "name", "n", "", "")
 	command.Flags().StringVar(&domain, "domain", "", "")
 	command.Flags().StringVar(&email, "email", "", "")
+	command.Flags().StringVar(&dnsDomain, "dns-domain", "", "Custom MagicDNS domain of the Tailnet, e.g. corp.example.net")
 
 	command.PreRunE = func(cmd *cobra.Command, args []string) error {
 		if name == "" {
@@ -103,6 +111,7 @@ func createTailnetsCommand() *cobra.Command {
 	command.RunE = func(cmd *cobra.Command, args []string) error {
 
 		dnsConfig := defaults.DefaultDNSConfig()
+		dnsConfig.Domain = dnsDomain
 		aclPolicy := defaults.DefaultACLPolicy().Marshal()
 		iamPolicy := "{}"
 
@@ -457,3 +466,115 @@ func disableMachineAuthorizationCommand() *cobra.Command {
 
 	return command
 }
+
+func enableSCIMCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "enable-scim",
+		Short:        "Enable SCIM provisioning of users and groups, generating a new SCIM token.",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.EnableSCIMRequest{
+			TailnetId: tc.TailnetID(),
+		}
+
+		resp, err := tc.Client().EnableSCIM(cmd.Context(), connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("")
+		fmt.Println("SCIM provisioning is enabled, configure your identity provider with:")
+		fmt.Println("")
+		fmt.Printf("  Base URL: %s\n", resp.Msg.Url)
+		fmt.Printf("  Token:    %s\n", resp.Msg.Token)
+		fmt.Println("")
+		fmt.Println("Any previously generated SCIM token is revoked.")
+
+		return nil
+	}
+
+	return command
+}
+
+func disableSCIMCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "disable-scim",
+		Short:        "Disable SCIM provisioning, revoking the SCIM token.",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.DisableSCIMRequest{
+			TailnetId: tc.TailnetID(),
+		}
+
+		if _, err := tc.Client().DisableSCIM(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	return command
+}
+
+func getAuthProvidersCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "get-auth-providers",
+		Short:        "Get the auth providers allowed to join the tailnet",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		resp, err := tc.Client().GetAuthProviders(cmd.Context(), connect.NewRequest(&api.GetAuthProvidersRequest{TailnetId: tc.TailnetID()}))
+		if err != nil {
+			return err
+		}
+
+		allowed := resp.Msg.Providers
+		if len(allowed) == 0 {
+			allowed = resp.Msg.Available
+		}
+
+		tbl := table.New("PROVIDER", "ALLOWED")
+		for _, p := range resp.Msg.Available {
+			tbl.AddRow(p, slices.Contains(allowed, p))
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
+
+func setAuthProvidersCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "set-auth-providers",
+		Short:        "Set the auth providers allowed to join the tailnet, when none are given all providers are allowed",
+		SilenceUsage: true,
+	})
+
+	var providers []string
+
+	command.Flags().StringSliceVar(&providers, "provider", []string{}, "Name of an auth provider allowed to join the tailnet")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.SetAuthProvidersRequest{
+			TailnetId: tc.TailnetID(),
+			Providers: providers,
+		}
+
+		if _, err := tc.Client().SetAuthProviders(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Auth providers updated successfully")
+
+		return nil
+	}
+
+	return command
+}
@@ -13,6 +13,7 @@ import (
 const (
 	ionscaleSystemAdminKey     = "IONSCALE_SYSTEM_ADMIN_KEY"
 	ionscaleKeysSystemAdminKey = "IONSCALE_KEYS_SYSTEM_ADMIN_KEY"
+	ionscaleApiKey             = "IONSCALE_API_KEY"
 	ionscaleAddr               = "IONSCALE_ADDR"
 	ionscaleInsecureSkipVerify = "IONSCALE_SKIP_VERIFY"
 )
@@ -27,6 +28,7 @@ type target struct {
 	addr               string
 	insecureSkipVerify bool
 	systemAdminKey     string
+	apiKey             string
 
 	tailnetID   uint64
 	tailnetName string
@@ -41,6 +43,7 @@ func prepareCommand(enableTailnetSelector bool, cmd *cobra.Command) (*cobra.Comm
 	cmd.Flags().StringVar(&t.addr, "addr", "", "Addr of the ionscale server, as a complete URL")
 	cmd.Flags().BoolVar(&t.insecureSkipVerify, "tls-skip-verify", false, "Disable verification of TLS certificates")
 	cmd.Flags().StringVar(&t.systemAdminKey, "system-admin-key", "", "If specified, the given value will be used as the key to generate a Bearer token for the call. This can also be specified via the IONSCALE_ADMIN_KEY environment variable.")
+	cmd.Flags().StringVar(&t.apiKey, "api-key", "", "If specified, the given api key will be used as Bearer token for the call. This can also be specified via the IONSCALE_API_KEY environment variable.")
 
 	if enableTailnetSelector {
 		cmd.Flags().StringVar(&t.tailnetName, "tailnet", "", "Tailnet name. Mutually exclusive with --tailnet-id.")
@@ -51,10 +54,17 @@ func prepareCommand(enableTailnetSelector bool, cmd *cobra.Command) (*cobra.Comm
 		addr := t.getAddr()
 		skipVerify := t.getInsecureSkipVerify()
 		systemAdminKey := t.getSystemAdminKey()
+		apiKey := t.getApiKey()
 
-		auth, err := ionscale.LoadClientAuth(addr, systemAdminKey)
-		if err != nil {
-			return err
+		var auth ionscale.ClientAuth
+		if apiKey != "" && systemAdminKey == "" {
+			auth = ionscale.ApiKeyAuth(apiKey)
+		} else {
+			a, err := ionscale.LoadClientAuth(addr, systemAdminKey)
+			if err != nil {
+				return err
+			}
+			auth = a
 		}
 
 		client, err := ionscale.NewClient(auth, addr, skipVerify)
@@ -67,7 +77,9 @@ func prepareCommand(enableTailnetSelector bool, cmd *cobra.Command) (*cobra.Comm
 		if enableTailnetSelector {
 			savedTailnetID := auth.TailnetID()
 
-			if savedTailnetID == 0 && !cmd.Flags().Changed("tailnet") && !cmd.Flags().Changed("tailnet-id") {
+			tailnetSelected := cmd.Flags().Changed("tailnet") || cmd.Flags().Changed("tailnet-id")
+
+			if savedTailnetID == 0 && apiKey == "" && !tailnetSelected {
 				return fmt.Errorf("flag --tailnet or --tailnet-id is required")
 			}
 
@@ -87,6 +99,11 @@ func prepareCommand(enableTailnetSelector bool, cmd *cobra.Command) (*cobra.Comm
 				}
 			}
 
+			// an api key belongs to a single tailnet, which is selected when no tailnet is given
+			if t.tailnet == nil && apiKey != "" && !tailnetSelected && len(tailnets.Msg.Tailnet) == 1 {
+				t.tailnet = tailnets.Msg.Tailnet[0]
+			}
+
 			if t.tailnet == nil {
 				return fmt.Errorf("requested tailnet not found or you are not authorized for this tailnet")
 			}
@@ -119,6 +136,13 @@ func (t *target) getSystemAdminKey() string {
 	return config.GetString(ionscaleSystemAdminKey, config.GetString(ionscaleKeysSystemAdminKey, ""))
 }
 
+func (t *target) getApiKey() string {
+	if len(t.apiKey) != 0 {
+		return t.apiKey
+	}
+	return config.GetString(ionscaleApiKey, "")
+}
+
 func (t *target) Addr() string {
 	return t.getAddr()
 }
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	str2dur "github.com/xhit/go-str2duration/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+func temporaryGrantsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:     "grants",
+		Aliases: []string{"grant"},
+		Short:   "Manage temporary access grants",
+	}
+
+	command.AddCommand(requestTemporaryGrantCommand())
+	command.AddCommand(approveTemporaryGrantCommand())
+	command.AddCommand(revokeTemporaryGrantCommand())
+	command.AddCommand(listTemporaryGrantsCommand())
+
+	return command
+}
+
+func requestTemporaryGrantCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "request",
+		Short:        "Requests temporary access to a destination",
+		SilenceUsage: true,
+	})
+
+	var src string
+	var dst string
+	var ports string
+	var duration string
+	var reason string
+
+	command.Flags().StringVar(&src, "src", "", "User or group receiving access")
+	command.Flags().StringVar(&dst, "dst", "", "Destination, e.g. a tag, host or IP address")
+	command.Flags().StringVar(&ports, "ports", "*", "Destination ports, e.g. 22 or 80,443")
+	command.Flags().StringVar(&duration, "duration", "1h", "Human-readable duration of the access after approval")
+	command.Flags().StringVar(&reason, "reason", "", "Reason for requesting access")
+
+	_ = command.MarkFlagRequired("src")
+	_ = command.MarkFlagRequired("dst")
+	_ = command.MarkFlagRequired("reason")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		d, err := str2dur.ParseDuration(duration)
+		if err != nil {
+			return err
+		}
+
+		req := &api.RequestTemporaryGrantRequest{
+			TailnetId: tc.TailnetID(),
+			Src:       src,
+			Dst:       dst,
+			Ports:     ports,
+			Duration:  durationpb.New(d),
+			Reason:    reason,
+		}
+
+		resp, err := tc.Client().RequestTemporaryGrant(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Access requested, the grant with id %d is pending until approved by an admin.\n", resp.Msg.Grant.Id)
+
+		return nil
+	}
+
+	return command
+}
+
+func approveTemporaryGrantCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "approve",
+		Short:        "Approves a temporary access grant",
+		SilenceUsage: true,
+	})
+
+	var grantID uint64
+	command.Flags().Uint64Var(&grantID, "grant-id", 0, "Grant ID.")
+
+	_ = command.MarkFlagRequired("grant-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.ApproveTemporaryGrantRequest{GrantId: grantID}
+		resp, err := tc.Client().ApproveTemporaryGrant(cmd.Context(), connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Grant approved, access expires at %s.\n", resp.Msg.Grant.ExpiresAt.AsTime().Local().Format(time.RFC3339))
+
+		return nil
+	}
+
+	return command
+}
+
+func revokeTemporaryGrantCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "revoke",
+		Short:        "Revokes a temporary access grant",
+		SilenceUsage: true,
+	})
+
+	var grantID uint64
+	command.Flags().Uint64Var(&grantID, "grant-id", 0, "Grant ID.")
+
+	_ = command.MarkFlagRequired("grant-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.RevokeTemporaryGrantRequest{GrantId: grantID}
+		if _, err := tc.Client().RevokeTemporaryGrant(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("Grant revoked.")
+
+		return nil
+	}
+
+	return command
+}
+
+func listTemporaryGrantsCommand() *cobra.Command {
+	command, tc := prepareCommand(true, &cobra.Command{
+		Use:          "list",
+		Short:        "List temporary access grants",
+		SilenceUsage: true,
+	})
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.ListTemporaryGrantsRequest{TailnetId: tc.TailnetID()}
+		resp, err := tc.Client().ListTemporaryGrants(cmd.Context(), connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+
+		tbl := table.New("ID", "SRC", "DST", "PORTS", "DURATION", "STATE", "REQUESTED BY", "APPROVED BY", "EXPIRES", "REASON")
+		for _, g := range resp.Msg.Grants {
+			var expires string
+			if g.ExpiresAt != nil {
+				expires = g.ExpiresAt.AsTime().Local().Format(time.RFC3339)
+			}
+			tbl.AddRow(g.Id, g.Src, g.Dst, g.Ports, g.Duration.AsDuration(), g.State, g.RequestedBy, g.ApprovedBy, expires, g.Reason)
+		}
+		tbl.Print()
+
+		return nil
+	}
+
+	return command
+}
@@ -18,6 +18,8 @@ func userCommands() *cobra.Command {
 
 	command.AddCommand(listUsersCommand())
 	command.AddCommand(deleteUserCommand())
+	command.AddCommand(suspendUserCommand())
+	command.AddCommand(reactivateUserCommand())
 
 	return command
 }
@@ -37,9 +39,9 @@ func listUsersCommand() *cobra.Command {
 			return err
 		}
 
-		tbl := table.New("ID", "USER", "ROLE")
+		tbl := table.New("ID", "USER", "ROLE", "SUSPENDED")
 		for _, m := range resp.Msg.Users {
-			tbl.AddRow(m.Id, m.Name, m.Role)
+			tbl.AddRow(m.Id, m.Name, m.Role, m.Suspended)
 		}
 		tbl.Print()
 
@@ -75,3 +77,57 @@ func deleteUserCommand() *cobra.Command {
 
 	return command
 }
+
+func suspendUserCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "suspend",
+		Short:        "Suspends a user, keeping the user and its machines",
+		SilenceUsage: true,
+	})
+
+	var userID uint64
+
+	command.Flags().Uint64Var(&userID, "user-id", 0, "User ID.")
+
+	_ = command.MarkFlagRequired("user-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.SuspendUserRequest{UserId: userID}
+		if _, err := tc.Client().SuspendUser(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("User suspended.")
+
+		return nil
+	}
+
+	return command
+}
+
+func reactivateUserCommand() *cobra.Command {
+	command, tc := prepareCommand(false, &cobra.Command{
+		Use:          "reactivate",
+		Short:        "Reactivates a suspended user",
+		SilenceUsage: true,
+	})
+
+	var userID uint64
+
+	command.Flags().Uint64Var(&userID, "user-id", 0, "User ID.")
+
+	_ = command.MarkFlagRequired("user-id")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		req := api.ReactivateUserRequest{UserId: userID}
+		if _, err := tc.Client().ReactivateUser(cmd.Context(), connect.NewRequest(&req)); err != nil {
+			return err
+		}
+
+		fmt.Println("User reactivated.")
+
+		return nil
+	}
+
+	return command
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sigs.k8s.io/yaml"
+	"slices"
 	"strings"
 	"tailscale.com/tailcfg"
 	tkey "tailscale.com/types/key"
@@ -23,12 +24,26 @@ import (
 const (
 	defaultKeepAliveInterval = 1 * time.Minute
 	defaultMagicDNSSuffix    = "ionscale.net"
+	defaultDNSRecordTTL      = 10 * time.Minute
+	defaultDERPProbeInterval = 1 * time.Minute
+	defaultDERPProbeTimeout  = 10 * time.Second
+	defaultApiKeyExpiry      = 24 * time.Hour
+	defaultApiKeyMaxExpiry   = 90 * 24 * time.Hour
+
+	DefaultAuthProviderName = domain.DefaultAuthProvider
+
+	AuthProviderTypeOIDC   = "oidc"
+	AuthProviderTypeGitHub = "github"
+	AuthProviderTypeGitLab = "gitlab"
 )
 
+var authProviderNameRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
 var (
 	keepAliveInterval     = defaultKeepAliveInterval
 	magicDNSSuffix        = defaultMagicDNSSuffix
 	dnsProviderConfigured = false
+	dnsProviderZone       = ""
 )
 
 func KeepAliveInterval() time.Duration {
@@ -43,6 +58,10 @@ func DNSProviderConfigured() bool {
 	return dnsProviderConfigured
 }
 
+func DNSProviderZone() string {
+	return dnsProviderZone
+}
+
 func LoadConfig(path string) (*Config, error) {
 	cfg := defaultConfig()
 
@@ -92,8 +111,9 @@ func LoadConfig(path string) (*Config, error) {
 	keepAliveInterval = time.Duration(cfg.PollNet.KeepAliveInterval)
 	magicDNSSuffix = cfg.DNS.MagicDNSSuffix
 
-	if cfg.DNS.Provider.Zone != "" {
+	if zone := cfg.DNS.ProviderZone(); zone != "" {
 		dnsProviderConfigured = true
+		dnsProviderZone = zone
 	}
 
 	return cfg.Validate()
@@ -121,6 +141,12 @@ func defaultConfig() *Config {
 		},
 		DNS: DNS{
 			MagicDNSSuffix: defaultMagicDNSSuffix,
+			Provider: DNSProvider{
+				RecordTTL: Duration(defaultDNSRecordTTL),
+			},
+			Server: DNSServer{
+				RecordTTL: Duration(defaultDNSRecordTTL),
+			},
 		},
 		DERP: DERP{
 			Server: DERPServer{
@@ -129,6 +155,16 @@ func defaultConfig() *Config {
 				RegionCode: "ionscale",
 				RegionName: "ionscale Embedded DERP",
 			},
+			Prober: DERPProber{
+				Interval: Duration(defaultDERPProbeInterval),
+				Timeout:  Duration(defaultDERPProbeTimeout),
+			},
+		},
+		Auth: Auth{
+			ApiKeys: ApiKeys{
+				DefaultExpiry: Duration(defaultApiKeyExpiry),
+				MaxExpiry:     Duration(defaultApiKeyMaxExpiry),
+			},
 		},
 		Logging: Logging{
 			Level: "info",
@@ -202,11 +238,23 @@ type Keys struct {
 
 type Auth struct {
 	Provider          AuthProvider      `json:"provider,omitempty"`
+	Providers         []AuthProvider    `json:"providers,omitempty"`
 	SystemAdminPolicy SystemAdminPolicy `json:"system_admins"`
+	ApiKeys           ApiKeys           `json:"api_keys,omitempty"`
+}
+
+// ApiKeys limits the lifetime of the api keys created by users
+type ApiKeys struct {
+	DefaultExpiry Duration `json:"default_expiry,omitempty"`
+	MaxExpiry     Duration `json:"max_expiry,omitempty"`
 }
 
 type AuthProvider struct {
+	Name         string   `json:"name,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	DisplayName  string   `json:"display_name,omitempty"`
 	Issuer       string   `json:"issuer"`
+	URL          string   `json:"url,omitempty"`
 	ClientID     string   `json:"client_id"`
 	ClientSecret string   `json:"client_secret"`
 	Scopes       []string `json:"additional_scopes" `
@@ -215,6 +263,18 @@ type AuthProvider struct {
 type DNS struct {
 	MagicDNSSuffix string      `json:"magic_dns_suffix"`
 	Provider       DNSProvider `json:"provider,omitempty"`
+	Server         DNSServer   `json:"server,omitempty"`
+}
+
+// ProviderZone returns the zone managed by either the configured DNS provider or the embedded DNS server
+func (d DNS) ProviderZone() string {
+	if d.Server.ListenAddr != "" {
+		if d.Server.Zone != "" {
+			return d.Server.Zone
+		}
+		return d.MagicDNSSuffix
+	}
+	return d.Provider.Zone
 }
 
 type DNSProvider struct {
@@ -222,6 +282,24 @@ type DNSProvider struct {
 	PluginPath    string          `json:"plugin_path"`
 	Zone          string          `json:"zone"`
 	Configuration json.RawMessage `json:"config"`
+	RecordTTL     Duration        `json:"record_ttl,omitempty"`
+}
+
+// RecordTTL returns how long the records set by the machines are kept,
+// before they are removed from the zone of the DNS provider or the embedded DNS server
+func (d DNS) RecordTTL() time.Duration {
+	if d.Server.ListenAddr != "" {
+		return d.Server.RecordTTL.Std()
+	}
+	return d.Provider.RecordTTL.Std()
+}
+
+// DNSServer configures the embedded authoritative DNS server, serving the records of the ACME DNS-01 challenges
+type DNSServer struct {
+	ListenAddr string   `json:"listen_addr,omitempty"`
+	Zone       string   `json:"zone,omitempty"`
+	Nameserver string   `json:"nameserver,omitempty"`
+	RecordTTL  Duration `json:"record_ttl,omitempty"`
 }
 
 type SystemAdminPolicy struct {
@@ -231,15 +309,144 @@ type SystemAdminPolicy struct {
 }
 
 type DERP struct {
-	Server  DERPServer `json:"server,omitempty"`
-	Sources []string   `json:"sources,omitempty"`
+	Server          DERPServer `json:"server,omitempty"`
+	Sources         []string   `json:"sources,omitempty"`
+	RefreshInterval Duration   `json:"refresh_interval,omitempty"`
+	Prober          DERPProber `json:"prober,omitempty"`
+}
+
+// DERPProber configures the periodic health checks of the nodes in the default and the tailnet DERP maps
+type DERPProber struct {
+	Enabled               bool     `json:"enabled,omitempty"`
+	Interval              Duration `json:"interval,omitempty"`
+	Timeout               Duration `json:"timeout,omitempty"`
+	DeprioritizeUnhealthy bool     `json:"deprioritize_unhealthy,omitempty"`
 }
 
 type DERPServer struct {
-	Disabled   bool   `json:"disabled,omitempty"`
-	RegionID   int    `json:"region_id,omitempty"`
-	RegionCode string `json:"region_code,omitempty"`
-	RegionName string `json:"region_name,omitempty"`
+	Disabled      bool   `json:"disabled,omitempty"`
+	RegionID      int    `json:"region_id,omitempty"`
+	RegionCode    string `json:"region_code,omitempty"`
+	RegionName    string `json:"region_name,omitempty"`
+	VerifyClients bool   `json:"verify_clients,omitempty"`
+
+	MeshKey   string         `json:"mesh_key,omitempty"`
+	MeshPeers []DERPMeshPeer `json:"mesh_peers,omitempty"`
+}
+
+// DERPMeshPeer is another ionscale instance serving the same DERP region. The embedded DERP servers forward packets
+// to each other, so clients connected to different instances can reach each other.
+type DERPMeshPeer struct {
+	PublicAddr     string `json:"public_addr"`
+	StunPublicAddr string `json:"stun_public_addr,omitempty"`
+	MeshURL        string `json:"mesh_url,omitempty"`
+
+	derpHost string
+	derpPort int
+	stunHost string
+	stunPort int
+	meshURL  string
+}
+
+// URL returns the url of the DERP server of the peer, used to set up the mesh connection
+func (p DERPMeshPeer) URL() string {
+	return p.meshURL
+}
+
+func (p *DERPMeshPeer) validate() error {
+	publicUrl, derpHost, derpPort, err := validatePublicAddr(p.PublicAddr)
+	if err != nil {
+		return fmt.Errorf("public addr: %w", err)
+	}
+
+	p.derpHost = derpHost
+	p.derpPort = derpPort
+	p.stunPort = -1
+	p.meshURL = publicUrl.JoinPath("derp").String()
+
+	if p.StunPublicAddr != "" {
+		_, stunHost, stunPort, err := validatePublicAddr(p.StunPublicAddr)
+		if err != nil {
+			return fmt.Errorf("stun public addr: %w", err)
+		}
+		p.stunHost = stunHost
+		p.stunPort = stunPort
+	}
+
+	if p.MeshURL != "" {
+		u, err := url.Parse(p.MeshURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("mesh url: invalid")
+		}
+		p.meshURL = p.MeshURL
+	}
+
+	return nil
+}
+
+// HostName returns the hostname of the DERP server of the peer, which is also its node name in the DERP map
+func (p DERPMeshPeer) HostName() string {
+	return p.derpHost
+}
+
+func (p DERPMeshPeer) nodes(regionID int) []*tailcfg.DERPNode {
+	if p.stunHost == "" || p.stunHost == p.derpHost {
+		return []*tailcfg.DERPNode{
+			{
+				RegionID: regionID,
+				Name:     p.derpHost,
+				HostName: p.derpHost,
+				DERPPort: p.derpPort,
+				STUNPort: p.stunPort,
+			},
+		}
+	}
+
+	return []*tailcfg.DERPNode{
+		{
+			RegionID: regionID,
+			Name:     p.derpHost + "-stun",
+			HostName: p.stunHost,
+			STUNOnly: true,
+			STUNPort: p.stunPort,
+		},
+		{
+			RegionID: regionID,
+			Name:     p.derpHost,
+			HostName: p.derpHost,
+			DERPPort: p.derpPort,
+			STUNPort: -1,
+		},
+	}
+}
+
+func (d *DERPServer) validateMesh(derpHost string) error {
+	if len(d.MeshPeers) == 0 {
+		return nil
+	}
+
+	if d.Disabled {
+		return fmt.Errorf("mesh peers require the embedded DERP server")
+	}
+
+	if d.MeshKey == "" {
+		return fmt.Errorf("mesh_key is required when mesh peers are configured")
+	}
+
+	hosts := map[string]bool{derpHost: true}
+	for i := range d.MeshPeers {
+		p := &d.MeshPeers[i]
+		if err := p.validate(); err != nil {
+			return fmt.Errorf("mesh peer %d: %w", i, err)
+		}
+
+		if hosts[p.derpHost] {
+			return fmt.Errorf("mesh peer %d: duplicate host [%s]", i, p.derpHost)
+		}
+		hosts[p.derpHost] = true
+	}
+
+	return nil
 }
 
 func (c *Config) Validate() (*Config, error) {
@@ -262,9 +469,77 @@ func (c *Config) Validate() (*Config, error) {
 		c.stunPort = stunPort
 	}
 
+	if err := c.DERP.Server.validateMesh(c.derpHost); err != nil {
+		return nil, fmt.Errorf("derp: %w", err)
+	}
+
+	if c.DERP.RefreshInterval < 0 {
+		return nil, fmt.Errorf("derp: refresh_interval must not be negative")
+	}
+
+	if c.DERP.Prober.Enabled && (c.DERP.Prober.Interval <= 0 || c.DERP.Prober.Timeout <= 0) {
+		return nil, fmt.Errorf("derp: prober interval and timeout must be positive")
+	}
+
+	if err := c.Auth.validate(); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+
+	if c.Auth.ApiKeys.DefaultExpiry <= 0 || c.Auth.ApiKeys.DefaultExpiry > c.Auth.ApiKeys.MaxExpiry {
+		return nil, fmt.Errorf("auth: api_keys default_expiry must be positive and not exceed max_expiry")
+	}
+
+	if c.DNS.Server.ListenAddr != "" {
+		if c.DNS.Provider.Zone != "" {
+			return nil, fmt.Errorf("dns: only one of provider or server can be configured")
+		}
+		if c.DNS.Server.Nameserver == "" {
+			c.DNS.Server.Nameserver = c.PublicUrl.Hostname()
+		}
+	}
+
 	return c, nil
 }
 
+// AuthProviders returns all configured auth providers, the single provider configured with 'provider'
+// is registered first with the name 'default', as the existing accounts are migrated to that provider
+func (a *Auth) AuthProviders() []AuthProvider {
+	var result []AuthProvider
+	if a.Provider.Issuer != "" || a.Provider.Type != "" {
+		p := a.Provider
+		p.Name = DefaultAuthProviderName
+		result = append(result, p)
+	}
+	return append(result, a.Providers...)
+}
+
+func (a *Auth) validate() error {
+	if a.Provider.Name != "" && a.Provider.Name != DefaultAuthProviderName {
+		return fmt.Errorf("the provider configured with 'provider' is always named '%s', use 'providers' for named providers", DefaultAuthProviderName)
+	}
+
+	names := make(map[string]bool)
+	for _, p := range a.AuthProviders() {
+		if !authProviderNameRegex.MatchString(p.Name) {
+			return fmt.Errorf("invalid provider name '%s', only lowercase letters, digits, '-' and '_' are allowed", p.Name)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("duplicate provider name '%s'", p.Name)
+		}
+		switch p.Type {
+		case "", AuthProviderTypeOIDC:
+			if p.Issuer == "" {
+				return fmt.Errorf("provider '%s': issuer is required", p.Name)
+			}
+		case AuthProviderTypeGitHub, AuthProviderTypeGitLab:
+		default:
+			return fmt.Errorf("provider '%s': unsupported type '%s'", p.Name, p.Type)
+		}
+		names[p.Name] = true
+	}
+	return nil
+}
+
 func (c *Config) CreateUrl(format string, a ...interface{}) string {
 	path := fmt.Sprintf(format, a...)
 	u := url.URL{
@@ -310,49 +585,58 @@ func (c *Config) ReadServerKeys(defaultKeys *domain.ControlKeys) (*ServerKeys, e
 }
 
 func (c *Config) DefaultDERPMap() *tailcfg.DERPMap {
-	if c.derpHost == c.stunHost {
-		return &tailcfg.DERPMap{
-			Regions: map[int]*tailcfg.DERPRegion{
-				c.DERP.Server.RegionID: {
-					RegionID:   c.DERP.Server.RegionID,
-					RegionCode: c.DERP.Server.RegionCode,
-					RegionName: c.DERP.Server.RegionName,
-					Nodes: []*tailcfg.DERPNode{
-						{
-							RegionID: c.DERP.Server.RegionID,
-							Name:     "ionscale",
-							HostName: c.derpHost,
-							DERPPort: c.derpPort,
-							STUNPort: c.stunPort,
-						},
-					},
-				},
-			},
+	regionID := c.DERP.Server.RegionID
+
+	var nodes []*tailcfg.DERPNode
+	if len(c.DERP.Server.MeshPeers) != 0 {
+		// every instance of the mesh advertises the same nodes, named and sorted by hostname,
+		// so all instances serve an identical DERP map
+		instances := append([]DERPMeshPeer{{
+			derpHost: c.derpHost,
+			derpPort: c.derpPort,
+			stunHost: c.stunHost,
+			stunPort: c.stunPort,
+		}}, c.DERP.Server.MeshPeers...)
+
+		slices.SortFunc(instances, func(a, b DERPMeshPeer) int { return strings.Compare(a.derpHost, b.derpHost) })
+
+		for _, p := range instances {
+			nodes = append(nodes, p.nodes(regionID)...)
 		}
+	} else if c.derpHost == c.stunHost {
+		nodes = append(nodes, &tailcfg.DERPNode{
+			RegionID: regionID,
+			Name:     "ionscale",
+			HostName: c.derpHost,
+			DERPPort: c.derpPort,
+			STUNPort: c.stunPort,
+		})
+	} else {
+		nodes = append(nodes,
+			&tailcfg.DERPNode{
+				RegionID: regionID,
+				Name:     "stun",
+				HostName: c.stunHost,
+				STUNOnly: true,
+				STUNPort: c.stunPort,
+			},
+			&tailcfg.DERPNode{
+				RegionID: regionID,
+				Name:     "derp",
+				HostName: c.derpHost,
+				DERPPort: c.derpPort,
+				STUNPort: -1,
+			},
+		)
 	}
 
 	return &tailcfg.DERPMap{
 		Regions: map[int]*tailcfg.DERPRegion{
-			c.DERP.Server.RegionID: {
-				RegionID:   c.DERP.Server.RegionID,
+			regionID: {
+				RegionID:   regionID,
 				RegionCode: c.DERP.Server.RegionCode,
 				RegionName: c.DERP.Server.RegionName,
-				Nodes: []*tailcfg.DERPNode{
-					{
-						RegionID: c.DERP.Server.RegionID,
-						Name:     "stun",
-						HostName: c.stunHost,
-						STUNOnly: true,
-						STUNPort: c.stunPort,
-					},
-					{
-						RegionID: c.DERP.Server.RegionID,
-						Name:     "derp",
-						HostName: c.derpHost,
-						DERPPort: c.derpPort,
-						STUNPort: -1,
-					},
-				},
+				Nodes:      nodes,
 			},
 		},
 	}
@@ -128,3 +128,137 @@ func TestExpandEnvVars(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthProviders(t *testing.T) {
+	t.Run("Legacy provider is named default", func(t *testing.T) {
+		auth := Auth{
+			Provider:  AuthProvider{Issuer: "https://idp.example.com"},
+			Providers: []AuthProvider{{Name: "contractors", Issuer: "https://idp.contractor.com"}},
+		}
+
+		require.NoError(t, auth.validate())
+
+		providers := auth.AuthProviders()
+		require.Len(t, providers, 2)
+		require.Equal(t, "default", providers[0].Name)
+		require.Equal(t, "contractors", providers[1].Name)
+	})
+
+	t.Run("Legacy provider can't be renamed", func(t *testing.T) {
+		auth := Auth{
+			Provider: AuthProvider{Name: "corp", Issuer: "https://idp.example.com"},
+		}
+
+		require.Error(t, auth.validate())
+
+		auth.Provider.Name = "default"
+		require.NoError(t, auth.validate())
+		require.Equal(t, "default", auth.AuthProviders()[0].Name)
+	})
+
+	t.Run("Duplicate names", func(t *testing.T) {
+		auth := Auth{
+			Providers: []AuthProvider{
+				{Name: "corp", Issuer: "https://idp.example.com"},
+				{Name: "corp", Issuer: "https://idp.contractor.com"},
+			},
+		}
+
+		require.Error(t, auth.validate())
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		auth := Auth{
+			Providers: []AuthProvider{{Name: "My Provider", Issuer: "https://idp.example.com"}},
+		}
+
+		require.Error(t, auth.validate())
+	})
+
+	t.Run("OAuth2 providers", func(t *testing.T) {
+		auth := Auth{
+			Provider:  AuthProvider{Type: "github"},
+			Providers: []AuthProvider{{Name: "gitlab", Type: "gitlab", URL: "https://gitlab.example.com"}},
+		}
+
+		require.NoError(t, auth.validate())
+		require.Len(t, auth.AuthProviders(), 2)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		auth := Auth{
+			Providers: []AuthProvider{{Name: "bitbucket", Type: "bitbucket"}},
+		}
+
+		require.Error(t, auth.validate())
+	})
+}
+
+func TestDERPMeshPeers(t *testing.T) {
+	newConfig := func(mesh func(s *DERPServer)) (*Config, error) {
+		c := defaultConfig()
+		c.PublicAddr = "ionscale-a.example.com:443"
+		c.StunPublicAddr = "ionscale-a.example.com:3478"
+		mesh(&c.DERP.Server)
+		return c.Validate()
+	}
+
+	_, err := newConfig(func(s *DERPServer) {
+		s.MeshPeers = []DERPMeshPeer{{PublicAddr: "ionscale-b.example.com:443"}}
+	})
+	require.Error(t, err)
+
+	_, err = newConfig(func(s *DERPServer) {
+		s.MeshKey = "secret"
+		s.MeshPeers = []DERPMeshPeer{{PublicAddr: "ionscale-b.example.com"}}
+	})
+	require.Error(t, err)
+
+	_, err = newConfig(func(s *DERPServer) {
+		s.MeshKey = "secret"
+		s.MeshPeers = []DERPMeshPeer{{PublicAddr: "ionscale-b.example.com:443", MeshURL: "10.0.0.2"}}
+	})
+	require.Error(t, err)
+
+	_, err = newConfig(func(s *DERPServer) {
+		s.MeshKey = "secret"
+		s.MeshPeers = []DERPMeshPeer{{PublicAddr: "ionscale-a.example.com:443"}}
+	})
+	require.Error(t, err)
+
+	c, err := newConfig(func(s *DERPServer) {
+		s.MeshKey = "secret"
+		s.MeshPeers = []DERPMeshPeer{
+			{PublicAddr: "ionscale-c.example.com:443", MeshURL: "http://10.0.0.3:8080/derp"},
+			{PublicAddr: "ionscale-b.example.com:443", StunPublicAddr: "ionscale-b.example.com:3478"},
+		}
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "http://10.0.0.3:8080/derp", c.DERP.Server.MeshPeers[0].URL())
+	require.Equal(t, "https://ionscale-b.example.com/derp", c.DERP.Server.MeshPeers[1].URL())
+
+	nodes := c.DefaultDERPMap().Regions[c.DERP.Server.RegionID].Nodes
+	require.Len(t, nodes, 3)
+	require.Equal(t, "ionscale-a.example.com", nodes[0].Name)
+	require.Equal(t, 3478, nodes[0].STUNPort)
+	require.Equal(t, "ionscale-b.example.com", nodes[1].Name)
+	require.Equal(t, 3478, nodes[1].STUNPort)
+	require.Equal(t, "ionscale-c.example.com", nodes[2].Name)
+	require.Equal(t, -1, nodes[2].STUNPort)
+
+	// the other instances of the mesh advertise an identical DERP map
+	other, err := newConfig(func(s *DERPServer) {})
+	require.NoError(t, err)
+	other.PublicAddr = "ionscale-b.example.com:443"
+	other.StunPublicAddr = "ionscale-b.example.com:3478"
+	other.DERP.Server.MeshKey = "secret"
+	other.DERP.Server.MeshPeers = []DERPMeshPeer{
+		{PublicAddr: "ionscale-a.example.com:443", StunPublicAddr: "ionscale-a.example.com:3478"},
+		{PublicAddr: "ionscale-c.example.com:443"},
+	}
+	other, err = other.Validate()
+	require.NoError(t, err)
+
+	require.Equal(t, c.DefaultDERPMap(), other.DefaultDERPMap())
+}
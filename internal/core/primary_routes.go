@@ -0,0 +1,89 @@
+package core
+
+import (
+	"github.com/jsiebens/ionscale/internal/domain"
+	"maps"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+// failbackDelay is the time a preferred subnet router needs to be online before it takes over its routes again,
+// to avoid flapping between routers when a router has an unstable connection.
+const failbackDelay = 1 * time.Minute
+
+type primaryRoutes struct {
+	routes   map[netip.Prefix]uint64
+	failback *time.Timer
+}
+
+// selectPrimaryRoutes selects for every enabled route the router handling the traffic for that route,
+// quarantined machines and machines of suspended users are never selected.
+// The current primary router keeps its routes as long as it is online, when it goes offline another online router
+// with the same route takes over. A router with a lower id is preferred and takes over the route again
+// after it is online for at least the failback delay.
+func (n *tailnetSessionManager) selectPrimaryRoutes(machines []domain.Machine) map[netip.Prefix]uint64 {
+	n.Lock()
+	defer n.Unlock()
+
+	routers := make(map[netip.Prefix][]uint64)
+	for _, m := range machines {
+		// isolated machines are hidden from all peers, they can't handle the traffic for a route
+		if m.IsIsolated() {
+			continue
+		}
+		for _, r := range slices.Concat(m.AllowIPs, m.AutoAllowIPs) {
+			if r.Bits() != 0 && !slices.Contains(routers[r], m.ID) {
+				routers[r] = append(routers[r], m.ID)
+			}
+		}
+	}
+
+	now := time.Now()
+	var nextFailback time.Duration
+
+	result := make(map[netip.Prefix]uint64)
+	for r, ids := range routers {
+		slices.Sort(ids)
+
+		current, ok := n.primaries.routes[r]
+		currentOnline := ok && slices.Contains(ids, current) && n.HasSession(current)
+
+		preferred, preferredOnline := ids[0], false
+		for _, id := range ids {
+			if n.HasSession(id) {
+				preferred, preferredOnline = id, true
+				break
+			}
+		}
+
+		switch {
+		case !currentOnline && (preferredOnline || !ok || !slices.Contains(ids, current)):
+			result[r] = preferred
+		case currentOnline && preferred != current:
+			if wait := n.onlineSince[preferred].Add(failbackDelay).Sub(now); wait > 0 {
+				result[r] = current
+				if nextFailback == 0 || wait < nextFailback {
+					nextFailback = wait
+				}
+			} else {
+				result[r] = preferred
+			}
+		default:
+			result[r] = current
+		}
+	}
+
+	n.primaries.routes = result
+
+	if n.primaries.failback != nil {
+		n.primaries.failback.Stop()
+		n.primaries.failback = nil
+	}
+
+	if nextFailback != 0 {
+		n.primaries.failback = time.AfterFunc(nextFailback, func() { n.NotifyAll() })
+	}
+
+	return maps.Clone(result)
+}
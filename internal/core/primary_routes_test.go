@@ -0,0 +1,85 @@
+package core
+
+import (
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestSelectPrimaryRoutes_Failover(t *testing.T) {
+	route := netip.MustParsePrefix("10.0.0.0/24")
+	machines := []domain.Machine{
+		{ID: 1, AllowIPs: []netip.Prefix{route}},
+		{ID: 2, AutoAllowIPs: []netip.Prefix{route}},
+	}
+
+	ch := make(chan *Ping, 1)
+
+	sm := NewPollMapSessionManager()
+	sm.Register(1, 1, ch)
+	sm.Register(1, 2, make(chan *Ping, 1))
+
+	assert.Equal(t, uint64(1), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	sm.Deregister(1, 1, ch)
+	assert.Equal(t, uint64(2), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	// the preferred router is back, but the current primary keeps the route until the failback delay has passed
+	sm.Register(1, 1, make(chan *Ping, 1))
+	assert.Equal(t, uint64(2), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	tsm := sm.(*pollMapSessionManager).load(1)
+	tsm.Lock()
+	tsm.onlineSince[1] = time.Now().Add(-failbackDelay)
+	tsm.Unlock()
+	assert.Equal(t, uint64(1), sm.SelectPrimaryRoutes(1, machines)[route])
+}
+
+func TestSelectPrimaryRoutes_AllRoutersOffline(t *testing.T) {
+	route := netip.MustParsePrefix("10.0.0.0/24")
+	machines := []domain.Machine{
+		{ID: 1, AllowIPs: []netip.Prefix{route}},
+		{ID: 2, AllowIPs: []netip.Prefix{route}},
+		{ID: 3, AllowIPs: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}},
+	}
+
+	ch := make(chan *Ping, 1)
+
+	sm := NewPollMapSessionManager()
+	sm.Register(1, 2, ch)
+
+	assert.Equal(t, uint64(2), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	sm.Deregister(1, 2, ch)
+
+	primaries := sm.SelectPrimaryRoutes(1, machines)
+	assert.Equal(t, uint64(2), primaries[route])
+	assert.Len(t, primaries, 1)
+}
+
+func TestSelectPrimaryRoutes_IsolatedPrimary(t *testing.T) {
+	route := netip.MustParsePrefix("10.0.0.0/24")
+	machines := []domain.Machine{
+		{ID: 1, AllowIPs: []netip.Prefix{route}},
+		{ID: 2, AllowIPs: []netip.Prefix{route}},
+		{ID: 3, AllowIPs: []netip.Prefix{route}, User: domain.User{Suspended: true}},
+	}
+
+	sm := NewPollMapSessionManager()
+	sm.Register(1, 1, make(chan *Ping, 1))
+	sm.Register(1, 2, make(chan *Ping, 1))
+	sm.Register(1, 3, make(chan *Ping, 1))
+
+	assert.Equal(t, uint64(1), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	// the primary is quarantined while online, the other online router takes over
+	machines[0].Quarantined = true
+	assert.Equal(t, uint64(2), sm.SelectPrimaryRoutes(1, machines)[route])
+
+	// a machine of a suspended user never becomes primary
+	machines[1].Quarantined = true
+	_, ok := sm.SelectPrimaryRoutes(1, machines)[route]
+	assert.False(t, ok)
+}
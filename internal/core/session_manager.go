@@ -1,7 +1,9 @@
 package core
 
 import (
+	"github.com/jsiebens/ionscale/internal/domain"
 	"github.com/puzpuzpuz/xsync/v3"
+	"net/netip"
 	"slices"
 	"sync"
 	"time"
@@ -14,6 +16,7 @@ type PollMapSessionManager interface {
 	Deregister(tailnetID uint64, machineID uint64, ch chan<- *Ping)
 	HasSession(tailnetID uint64, machineID uint64) bool
 	NotifyAll(tailnetID uint64, ignoreMachineIDs ...uint64)
+	SelectPrimaryRoutes(tailnetID uint64, machines []domain.Machine) map[netip.Prefix]uint64
 }
 
 func NewPollMapSessionManager() PollMapSessionManager {
@@ -29,9 +32,10 @@ type pollMapSessionManager struct {
 func (n *pollMapSessionManager) load(tailnetID uint64) *tailnetSessionManager {
 	m, _ := n.tailnets.LoadOrCompute(tailnetID, func() *tailnetSessionManager {
 		return &tailnetSessionManager{
-			targets:  make(map[uint64]chan<- *Ping),
-			timers:   make(map[uint64]*time.Timer),
-			sessions: xsync.NewMapOf[uint64, bool](),
+			targets:     make(map[uint64]chan<- *Ping),
+			timers:      make(map[uint64]*time.Timer),
+			sessions:    xsync.NewMapOf[uint64, bool](),
+			onlineSince: make(map[uint64]time.Time),
 		}
 	})
 	return m
@@ -53,11 +57,18 @@ func (n *pollMapSessionManager) NotifyAll(tailnetID uint64, ignoreMachineIDs ...
 	n.load(tailnetID).NotifyAll(ignoreMachineIDs...)
 }
 
+func (n *pollMapSessionManager) SelectPrimaryRoutes(tailnetID uint64, machines []domain.Machine) map[netip.Prefix]uint64 {
+	return n.load(tailnetID).selectPrimaryRoutes(machines)
+}
+
 type tailnetSessionManager struct {
 	sync.RWMutex
 	targets  map[uint64]chan<- *Ping
 	timers   map[uint64]*time.Timer
 	sessions *xsync.MapOf[uint64, bool]
+
+	onlineSince map[uint64]time.Time
+	primaries   primaryRoutes
 }
 
 func (n *tailnetSessionManager) NotifyAll(ignoreMachineIDs ...uint64) {
@@ -82,6 +93,10 @@ func (n *tailnetSessionManager) Register(machineID uint64, ch chan<- *Ping) {
 		close(curr)
 	}
 
+	if !n.HasSession(machineID) {
+		n.onlineSince[machineID] = time.Now()
+	}
+
 	n.targets[machineID] = ch
 	n.sessions.Store(machineID, true)
 
@@ -111,6 +126,7 @@ func (n *tailnetSessionManager) Deregister(machineID uint64, ch chan<- *Ping) {
 	}
 
 	delete(n.targets, machineID)
+	delete(n.onlineSince, machineID)
 	n.sessions.Store(machineID, false)
 
 	t, ok := n.timers[machineID]
@@ -2,19 +2,24 @@ package core
 
 import (
 	"context"
+	"github.com/jsiebens/ionscale/internal/dns"
 	"github.com/jsiebens/ionscale/internal/domain"
+	"go.uber.org/zap"
 	"time"
 )
 
 const (
 	ticker            = 10 * time.Minute
+	grantsTicker      = 1 * time.Minute
+	dnsRecordsTicker  = 1 * time.Minute
 	inactivityTimeout = 30 * time.Minute
 )
 
-func StartWorker(repository domain.Repository, sessionManager PollMapSessionManager) {
+func StartWorker(repository domain.Repository, sessionManager PollMapSessionManager, dnsProvider dns.Provider) {
 	r := &worker{
 		sessionManager: sessionManager,
 		repository:     repository,
+		dnsProvider:    dnsProvider,
 	}
 
 	go r.start()
@@ -23,13 +28,26 @@ func StartWorker(repository domain.Repository, sessionManager PollMapSessionMana
 type worker struct {
 	sessionManager PollMapSessionManager
 	repository     domain.Repository
+	dnsProvider    dns.Provider
 }
 
 func (r *worker) start() {
 	r.deleteInactiveEphemeralNodes()
+	r.expireTemporaryGrants()
+	r.deleteExpiredDNSRecords()
+
 	t := time.NewTicker(ticker)
-	for range t.C {
-		r.deleteInactiveEphemeralNodes()
+	g := time.NewTicker(grantsTicker)
+	d := time.NewTicker(dnsRecordsTicker)
+	for {
+		select {
+		case <-t.C:
+			r.deleteInactiveEphemeralNodes()
+		case <-g.C:
+			r.expireTemporaryGrants()
+		case <-d.C:
+			r.deleteExpiredDNSRecords()
+		}
 	}
 }
 
@@ -44,14 +62,22 @@ func (r *worker) deleteInactiveEphemeralNodes() {
 	}
 
 	var removedNodes = make(map[uint64][]uint64)
+	var sharingTailnets = make(map[uint64]bool)
 	for _, m := range machines {
 		if now.After(m.LastSeen.Add(inactivityTimeout)) {
+			sharingTailnetIDs, err := r.repository.ListSharingTailnetIDs(ctx, m.TailnetID)
+			if err != nil {
+				continue
+			}
 			ok, err := r.repository.DeleteMachine(ctx, m.ID)
 			if err != nil {
 				continue
 			}
 			if ok {
 				removedNodes[m.TailnetID] = append(removedNodes[m.TailnetID], m.ID)
+				for _, id := range sharingTailnetIDs {
+					sharingTailnets[id] = true
+				}
 			}
 		}
 	}
@@ -60,5 +86,67 @@ func (r *worker) deleteInactiveEphemeralNodes() {
 		for i, _ := range removedNodes {
 			r.sessionManager.NotifyAll(i)
 		}
+		for i := range sharingTailnets {
+			r.sessionManager.NotifyAll(i)
+		}
+	}
+}
+
+func (r *worker) expireTemporaryGrants() {
+	ctx := context.Background()
+
+	grants, err := r.repository.ListExpiredTemporaryGrants(ctx, time.Now().UTC())
+	if err != nil {
+		return
+	}
+
+	var tailnets = make(map[uint64]bool)
+	for _, g := range grants {
+		g.State = domain.TemporaryGrantExpired
+		if err := r.repository.SaveTemporaryGrant(ctx, &g); err != nil {
+			continue
+		}
+
+		zap.L().Info("temporary grant expired",
+			zap.Uint64("tailnet", g.TailnetID),
+			zap.Uint64("grant", g.ID),
+			zap.String("src", g.Source),
+			zap.String("dst", g.Destination),
+			zap.String("approved_by", g.ApprovedBy),
+		)
+
+		tailnets[g.TailnetID] = true
+	}
+
+	for i := range tailnets {
+		r.sessionManager.NotifyAll(i)
+	}
+}
+
+// deleteExpiredDNSRecords removes the records set by machines from the zone of the DNS provider,
+// once expired or when the machine is deleted
+func (r *worker) deleteExpiredDNSRecords() {
+	if r.dnsProvider == nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	records, err := r.repository.ListExpiredManagedDNSRecords(ctx, time.Now().UTC())
+	if err != nil {
+		zap.L().Error("unable to list expired dns records", zap.Error(err))
+		return
+	}
+
+	for _, record := range records {
+		if err := r.dnsProvider.DeleteRecord(ctx, record.Type, record.Name, record.Value); err != nil {
+			zap.L().Warn("unable to delete dns record", zap.String("type", record.Type), zap.String("name", record.Name), zap.Error(err))
+			continue
+		}
+
+		if err := r.repository.DeleteManagedDNSRecord(ctx, record.ID); err != nil {
+			zap.L().Error("unable to delete managed dns record", zap.Uint64("record", record.ID), zap.Error(err))
+			continue
+		}
 	}
 }
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWorker_ExpireTemporaryGrants(t *testing.T) {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	newGrant := func(tailnetID uint64, state domain.TemporaryGrantState, expiresAt *time.Time) *domain.TemporaryGrant {
+		g := &domain.TemporaryGrant{
+			ID:          util.NextID(),
+			TailnetID:   tailnetID,
+			Source:      "john@example.com",
+			Destination: "*",
+			Ports:       "*",
+			Duration:    time.Hour,
+			Reason:      "test",
+			State:       state,
+			RequestedAt: now,
+			ExpiresAt:   expiresAt,
+		}
+		require.NoError(t, repository.SaveTemporaryGrant(ctx, g))
+		return g
+	}
+
+	expired := newGrant(1, domain.TemporaryGrantApproved, &past)
+	active := newGrant(2, domain.TemporaryGrantApproved, &future)
+	revoked := newGrant(3, domain.TemporaryGrantRevoked, &past)
+
+	sessionManager := NewPollMapSessionManager()
+
+	notified := map[uint64]chan *Ping{}
+	for _, tailnetID := range []uint64{1, 2, 3} {
+		ch := make(chan *Ping, 1)
+		sessionManager.Register(tailnetID, util.NextID(), ch)
+		notified[tailnetID] = ch
+	}
+
+	w := &worker{repository: repository, sessionManager: sessionManager}
+	w.expireTemporaryGrants()
+
+	state := func(id uint64) domain.TemporaryGrantState {
+		g, err := repository.GetTemporaryGrant(ctx, id)
+		require.NoError(t, err)
+		return g.State
+	}
+
+	require.Equal(t, domain.TemporaryGrantExpired, state(expired.ID))
+	require.Equal(t, domain.TemporaryGrantApproved, state(active.ID))
+	require.Equal(t, domain.TemporaryGrantRevoked, state(revoked.ID))
+
+	// only the tailnet of the expired grant gets a new map
+	require.Len(t, notified[1], 1)
+	require.Len(t, notified[2], 0)
+	require.Len(t, notified[3], 0)
+}
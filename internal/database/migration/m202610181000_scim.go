@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+func m202610181000_scim() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610181000",
+		Migrate: func(db *gorm.DB) error {
+			type User struct {
+				ExternalID string
+				Suspended  bool
+			}
+
+			type Group struct {
+				ID         uint64 `gorm:"primaryKey;autoIncrement:false"`
+				Name       string
+				ExternalID string
+				Members    domain.GroupMembers
+				TailnetID  uint64 `gorm:"index"`
+			}
+
+			type SCIMToken struct {
+				ID        uint64 `gorm:"primaryKey;autoIncrement:false"`
+				Key       string `gorm:"type:varchar(64);uniqueIndex"`
+				Hash      string
+				CreatedAt time.Time
+				TailnetID uint64
+			}
+
+			return db.AutoMigrate(
+				&User{},
+				&Group{},
+				&SCIMToken{},
+			)
+		},
+		Rollback: nil,
+	}
+}
@@ -0,0 +1,33 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func m202610181200_auth_providers() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610181200",
+		Migrate: func(db *gorm.DB) error {
+			// existing accounts were authenticated by the single provider, which is now named 'default'
+			type Account struct {
+				Provider   string `gorm:"default:default;index:idx_accounts_provider_external_id,unique,priority:1"`
+				ExternalID string `gorm:"index:idx_accounts_provider_external_id,unique,priority:2"`
+			}
+
+			type Tailnet struct {
+				AuthProviders string
+			}
+
+			if err := db.Migrator().AddColumn(&Account{}, "Provider"); err != nil {
+				return err
+			}
+
+			return db.AutoMigrate(
+				&Account{},
+				&Tailnet{},
+			)
+		},
+		Rollback: nil,
+	}
+}
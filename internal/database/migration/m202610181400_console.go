@@ -0,0 +1,24 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func m202610181400_console() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610181400",
+		Migrate: func(db *gorm.DB) error {
+			type AuthenticationRequest struct {
+				AccountID   *uint64
+				SystemAdmin bool
+				TailnetIDs  string
+			}
+
+			return db.AutoMigrate(
+				&AuthenticationRequest{},
+			)
+		},
+		Rollback: nil,
+	}
+}
@@ -0,0 +1,28 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"time"
+)
+
+func m202610181600_machine_shares() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610181600",
+		Migrate: func(db *gorm.DB) error {
+			type MachineShare struct {
+				ID              uint64 `gorm:"primaryKey;autoIncrement:false"`
+				Accepted        bool
+				CreatedAt       time.Time
+				MachineID       uint64 `gorm:"index"`
+				SourceTailnetID uint64 `gorm:"index"`
+				TailnetID       uint64 `gorm:"index"`
+			}
+
+			return db.AutoMigrate(
+				&MachineShare{},
+			)
+		},
+		Rollback: nil,
+	}
+}
@@ -0,0 +1,38 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"time"
+)
+
+func m202610181800_temporary_grants() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610181800",
+		Migrate: func(db *gorm.DB) error {
+			type TemporaryGrant struct {
+				ID            uint64 `gorm:"primaryKey;autoIncrement:false"`
+				TailnetID     uint64 `gorm:"index"`
+				Source        string
+				Destination   string
+				Ports         string
+				Duration      time.Duration
+				Reason        string
+				State         string `gorm:"index"`
+				RequestedBy   string
+				RequestedByID uint64
+				RequestedAt   time.Time
+				ApprovedBy    string
+				ApprovedAt    *time.Time
+				RevokedBy     string
+				RevokedAt     *time.Time
+				ExpiresAt     *time.Time
+			}
+
+			return db.AutoMigrate(
+				&TemporaryGrant{},
+			)
+		},
+		Rollback: nil,
+	}
+}
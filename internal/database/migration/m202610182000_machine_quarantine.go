@@ -0,0 +1,29 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func m202610182000_machine_quarantine() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610182000",
+		Migrate: func(db *gorm.DB) error {
+			type Machine struct {
+				Quarantined      bool
+				QuarantineReason string
+			}
+
+			if err := db.Migrator().AddColumn(&Machine{}, "Quarantined"); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().AddColumn(&Machine{}, "QuarantineReason"); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Rollback: nil,
+	}
+}
@@ -0,0 +1,48 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"time"
+)
+
+func m202610190900_invites() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610190900",
+		Migrate: func(db *gorm.DB) error {
+			type Invite struct {
+				ID        uint64 `gorm:"primaryKey;autoIncrement:false"`
+				Key       string `gorm:"type:varchar(64);uniqueIndex"`
+				Hash      string
+				Email     string
+				Role      string
+				MaxUses   int
+				Uses      int
+				CreatedBy string
+				CreatedAt time.Time
+				ExpiresAt *time.Time
+				TailnetID uint64 `gorm:"index"`
+			}
+
+			type User struct {
+				InviteID   *uint64
+				InviteRole string
+			}
+
+			if err := db.AutoMigrate(&Invite{}); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().AddColumn(&User{}, "InviteID"); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().AddColumn(&User{}, "InviteRole"); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Rollback: nil,
+	}
+}
@@ -0,0 +1,27 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"time"
+)
+
+func m202610200900_managed_dns_records() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610200900",
+		Migrate: func(db *gorm.DB) error {
+			type ManagedDNSRecord struct {
+				ID        uint64 `gorm:"primaryKey;autoIncrement:false"`
+				Type      string
+				Name      string `gorm:"index"`
+				Value     string
+				CreatedAt time.Time
+				ExpiresAt time.Time `gorm:"index"`
+				MachineID uint64    `gorm:"index"`
+			}
+
+			return db.AutoMigrate(&ManagedDNSRecord{})
+		},
+		Rollback: nil,
+	}
+}
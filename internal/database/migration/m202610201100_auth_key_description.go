@@ -0,0 +1,20 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func m202610201100_auth_key_description() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610201100",
+		Migrate: func(db *gorm.DB) error {
+			type AuthKey struct {
+				Description string
+			}
+
+			return db.Migrator().AddColumn(&AuthKey{}, "Description")
+		},
+		Rollback: nil,
+	}
+}
@@ -22,6 +22,15 @@ func Migrations() []*gormigrate.Migration {
 		m202402120800_user_last_authenticated(),
 		m202403130830_json_to_text(),
 		m202502150830_use_hostname(),
+		m202610181000_scim(),
+		m202610181200_auth_providers(),
+		m202610181400_console(),
+		m202610181600_machine_shares(),
+		m202610181800_temporary_grants(),
+		m202610182000_machine_quarantine(),
+		m202610190900_invites(),
+		m202610200900_managed_dns_records(),
+		m202610201100_auth_key_description(),
 	}
 	return migrations
 }
@@ -0,0 +1,471 @@
+package derp
+
+import (
+	"context"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"sync"
+	"tailscale.com/derp"
+	"tailscale.com/derp/derphttp"
+	"tailscale.com/net/netmon"
+	"tailscale.com/prober"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+	"tailscale.com/types/logger"
+	"time"
+)
+
+const maxConcurrentProbes = 16
+
+// stunProber only provides the UDP probe, it is never started and doesn't fetch a DERP map
+var stunProber, _ = prober.DERP(nil, "")
+
+var (
+	derpNodeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ionscale",
+		Name:      "derp_node_healthy",
+		Help:      "Whether the last probe of a DERP node succeeded",
+	}, []string{"region_id", "node", "host"})
+
+	derpProbeDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ionscale",
+		Name:      "derp_probe_duration_seconds",
+		Help:      "Duration of the last probe of a DERP node",
+	}, []string{"region_id", "node", "host"})
+
+	derpProbeFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ionscale",
+		Name:      "derp_probe_failures_total",
+		Help:      "Total amount of failed DERP node probes",
+	}, []string{"region_id", "node", "host", "check"})
+)
+
+// NodeHealth is the result of the last probe of a DERP node
+type NodeHealth struct {
+	DERPError string
+	STUNError string
+	Duration  time.Duration
+	CheckedAt time.Time
+}
+
+func (h NodeHealth) Healthy() bool {
+	return h.DERPError == "" && h.STUNError == ""
+}
+
+// nodeKey identifies a DERP node by its address, as the same node can be part of multiple DERP maps
+type nodeKey struct {
+	hostName string
+	ipv4     string
+	ipv6     string
+	derpPort int
+	stunPort int
+	stunOnly bool
+}
+
+func keyOf(n *tailcfg.DERPNode) nodeKey {
+	return nodeKey{
+		hostName: n.HostName,
+		ipv4:     n.IPv4,
+		ipv6:     n.IPv6,
+		derpPort: n.DERPPort,
+		stunPort: n.STUNPort,
+		stunOnly: n.STUNOnly,
+	}
+}
+
+type probeTarget struct {
+	region *tailcfg.DERPRegion
+	node   *tailcfg.DERPNode
+}
+
+func (t probeTarget) labels() prometheus.Labels {
+	return prometheus.Labels{"region_id": strconv.Itoa(t.region.RegionID), "node": t.node.Name, "host": t.node.HostName}
+}
+
+func (t probeTarget) checkLabels(check string) prometheus.Labels {
+	l := t.labels()
+	l["check"] = check
+	return l
+}
+
+// Prober periodically runs DERP connect and STUN checks against all nodes of the default DERP map
+// and the DERP maps of the tailnets, similar to tailscale's derpprobe.
+type Prober struct {
+	interval     time.Duration
+	timeout      time.Duration
+	deprioritize bool
+
+	privateKey     key.NodePrivate
+	repository     domain.Repository
+	sessionManager core.PollMapSessionManager
+	probe          func(ctx context.Context, region *tailcfg.DERPRegion, node *tailcfg.DERPNode) NodeHealth
+
+	lock    sync.RWMutex
+	results map[nodeKey]NodeHealth
+	targets map[nodeKey]probeTarget
+}
+
+// NewProber creates a prober for the given configuration, or returns nil when probing is disabled
+func NewProber(c *config.Config, repository domain.Repository, sessionManager core.PollMapSessionManager) *Prober {
+	if !c.DERP.Prober.Enabled {
+		return nil
+	}
+
+	privateKey := key.NewNode()
+
+	return &Prober{
+		interval:       c.DERP.Prober.Interval.Std(),
+		timeout:        c.DERP.Prober.Timeout.Std(),
+		deprioritize:   c.DERP.Prober.DeprioritizeUnhealthy,
+		privateKey:     privateKey,
+		repository:     repository,
+		sessionManager: sessionManager,
+		probe: func(ctx context.Context, region *tailcfg.DERPRegion, node *tailcfg.DERPNode) NodeHealth {
+			return probeNode(ctx, privateKey, region, node)
+		},
+		results: map[nodeKey]NodeHealth{},
+		targets: map[nodeKey]probeTarget{},
+	}
+}
+
+// PublicKey returns the node key used to connect to the DERP servers, if the prober is enabled
+func (p *Prober) PublicKey() (key.NodePublic, bool) {
+	if p == nil {
+		return key.NodePublic{}, false
+	}
+	return p.privateKey.Public(), true
+}
+
+func (p *Prober) Start(ctx context.Context) {
+	if p == nil {
+		return
+	}
+
+	go func() {
+		p.run(ctx)
+
+		t := time.NewTicker(p.interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				p.run(ctx)
+			}
+		}
+	}()
+}
+
+// Health returns the result of the last probe of the given node, if any
+func (p *Prober) Health(node *tailcfg.DERPNode) (NodeHealth, bool) {
+	if p == nil {
+		return NodeHealth{}, false
+	}
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	h, ok := p.results[keyOf(node)]
+	return h, ok
+}
+
+// Deprioritize moves the unhealthy nodes to the end of their region, and marks regions without any healthy DERP node
+// to be avoided as home region. The DERP map is returned as is when nothing changes or when disabled in the configuration.
+func (p *Prober) Deprioritize(dm *domain.DERPMap) *domain.DERPMap {
+	if p == nil || !p.deprioritize || dm == nil {
+		return dm
+	}
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	unhealthy := func(n *tailcfg.DERPNode) bool {
+		h, ok := p.results[keyOf(n)]
+		return ok && !h.Healthy()
+	}
+
+	var changed bool
+	result := dm.DERPMap.Clone()
+
+	for _, region := range result.Regions {
+		if !slices.ContainsFunc(region.Nodes, unhealthy) {
+			continue
+		}
+
+		var healthy, failing []*tailcfg.DERPNode
+		var relays int
+		for _, n := range region.Nodes {
+			if unhealthy(n) {
+				failing = append(failing, n)
+			} else {
+				healthy = append(healthy, n)
+				if !n.STUNOnly {
+					relays++
+				}
+			}
+		}
+
+		region.Nodes = append(healthy, failing...)
+		if relays == 0 {
+			region.Avoid = true
+		}
+		changed = true
+	}
+
+	if !changed {
+		return dm
+	}
+
+	wrapped := domain.WrapDERPMap(*result)
+	return &wrapped
+}
+
+func (p *Prober) run(ctx context.Context) {
+	maps, err := p.derpMaps(ctx)
+	if err != nil {
+		zap.L().Warn("unable to collect derp maps to probe", zap.Error(err))
+		return
+	}
+
+	targets := map[nodeKey]probeTarget{}
+	for _, dm := range maps {
+		for _, region := range dm.Regions {
+			for _, n := range region.Nodes {
+				if _, ok := targets[keyOf(n)]; !ok {
+					targets[keyOf(n)] = probeTarget{region: region, node: n}
+				}
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	results := map[nodeKey]NodeHealth{}
+
+	g := errgroup.Group{}
+	g.SetLimit(maxConcurrentProbes)
+	for k, t := range targets {
+		g.Go(func() error {
+			probeCtx, cancel := context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+
+			h := p.probe(probeCtx, t.region, t.node)
+
+			mu.Lock()
+			results[k] = h
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.lock.Lock()
+	previous := p.results
+	previousTargets := p.targets
+	p.results = results
+	p.targets = targets
+	p.lock.Unlock()
+
+	changed := map[nodeKey]bool{}
+	for k, t := range previousTargets {
+		if _, ok := targets[k]; !ok {
+			derpNodeHealthy.Delete(t.labels())
+			derpProbeDuration.Delete(t.labels())
+			derpProbeFailures.DeletePartialMatch(t.labels())
+		}
+	}
+
+	for k, h := range results {
+		t := targets[k]
+		if h.Healthy() {
+			derpNodeHealthy.With(t.labels()).Set(1)
+		} else {
+			derpNodeHealthy.With(t.labels()).Set(0)
+			zap.L().Warn("derp node is unhealthy",
+				zap.Int("region_id", t.region.RegionID),
+				zap.String("node", t.node.Name),
+				zap.String("derp_error", h.DERPError),
+				zap.String("stun_error", h.STUNError))
+		}
+		derpProbeDuration.With(t.labels()).Set(h.Duration.Seconds())
+
+		if h.DERPError != "" {
+			derpProbeFailures.With(t.checkLabels("derp")).Inc()
+		}
+		if h.STUNError != "" {
+			derpProbeFailures.With(t.checkLabels("stun")).Inc()
+		}
+
+		if prev, ok := previous[k]; (ok && prev.Healthy() != h.Healthy()) || (!ok && !h.Healthy()) {
+			changed[k] = true
+		}
+	}
+
+	if p.deprioritize {
+		p.notify(maps, changed)
+	}
+}
+
+// derpMaps collects the default DERP map, and the custom DERP maps of the tailnets, keyed by tailnet id.
+// Tailnets using the default DERP map are collected with key 0.
+func (p *Prober) derpMaps(ctx context.Context) (map[uint64]*tailcfg.DERPMap, error) {
+	tailnets, err := p.repository.ListTailnets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dm := domain.GetDefaultDERPMap()
+	maps := map[uint64]*tailcfg.DERPMap{0: &dm.DERPMap}
+	for _, t := range tailnets {
+		if t.DERPMap.Checksum != "" {
+			maps[t.ID] = &t.DERPMap.DERPMap
+		}
+	}
+
+	return maps, nil
+}
+
+// notify triggers a map update for the tailnets of which the DERP map includes a node with a changed health status
+func (p *Prober) notify(maps map[uint64]*tailcfg.DERPMap, changed map[nodeKey]bool) {
+	affected := func(dm *tailcfg.DERPMap) bool {
+		for _, region := range dm.Regions {
+			for _, n := range region.Nodes {
+				if changed[keyOf(n)] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if len(changed) == 0 {
+		return
+	}
+
+	tailnets, err := p.repository.ListTailnets(context.Background())
+	if err != nil {
+		zap.L().Warn("unable to list tailnets", zap.Error(err))
+		return
+	}
+
+	for _, t := range tailnets {
+		dm, ok := maps[t.ID]
+		if !ok {
+			dm = maps[0]
+		}
+
+		if affected(dm) {
+			p.sessionManager.NotifyAll(t.ID)
+		}
+	}
+}
+
+func probeNode(ctx context.Context, privateKey key.NodePrivate, region *tailcfg.DERPRegion, node *tailcfg.DERPNode) NodeHealth {
+	start := time.Now()
+	h := NodeHealth{}
+
+	if !node.STUNOnly {
+		if err := probeDERP(ctx, privateKey, region, node); err != nil {
+			h.DERPError = err.Error()
+		}
+	}
+
+	if node.STUNPort >= 0 {
+		if err := probeSTUN(ctx, node); err != nil {
+			h.STUNError = err.Error()
+		}
+	}
+
+	h.Duration = time.Since(start)
+	h.CheckedAt = time.Now().UTC()
+
+	return h
+}
+
+// probeDERP connects to the DERP node and waits for the server info message, like derpprobe does.
+// The DERP probes of tailscale's prober package can't be used here: they only accept a DERP map URL, while the
+// tailnet DERP maps are stored in the database, and they connect with a random node key which isn't accepted by
+// DERP servers verifying clients, whereas the key of this prober is trusted by the embedded DERP server.
+func probeDERP(ctx context.Context, privateKey key.NodePrivate, region *tailcfg.DERPRegion, node *tailcfg.DERPNode) error {
+	dc := derphttp.NewRegionClient(privateKey, logger.Discard, netmon.NewStatic(), func() *tailcfg.DERPRegion {
+		return &tailcfg.DERPRegion{
+			RegionID:   region.RegionID,
+			RegionCode: region.RegionCode,
+			RegionName: region.RegionName,
+			Nodes:      []*tailcfg.DERPNode{node},
+		}
+	})
+	dc.IsProber = true
+	defer dc.Close()
+
+	if err := dc.Connect(ctx); err != nil {
+		return err
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		m, err := dc.Recv()
+		if err != nil {
+			errc <- err
+			return
+		}
+		if _, ok := m.(derp.ServerInfoMessage); !ok {
+			errc <- fmt.Errorf("unexpected first message type %T", m)
+			return
+		}
+		errc <- nil
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timeout waiting for server info: %w", ctx.Err())
+	}
+}
+
+// probeSTUN sends a STUN binding request to the node, using the UDP probe of tailscale's prober package
+func probeSTUN(ctx context.Context, node *tailcfg.DERPNode) error {
+	ip, err := stunAddr(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	return stunProber.ProbeUDP(ip.String(), node.STUNPort).Probe(ctx)
+}
+
+func stunAddr(ctx context.Context, node *tailcfg.DERPNode) (netip.Addr, error) {
+	if ip, err := netip.ParseAddr(node.IPv4); err == nil {
+		return ip, nil
+	}
+
+	if ip, err := netip.ParseAddr(node.HostName); err == nil {
+		return ip, nil
+	}
+
+	ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip4", node.HostName)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if len(ips) == 0 {
+		return netip.Addr{}, fmt.Errorf("no ipv4 address found for %s", node.HostName)
+	}
+
+	return ips[0].Unmap(), nil
+}
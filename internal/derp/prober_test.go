@@ -0,0 +1,141 @@
+package derp
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"tailscale.com/net/stun/stuntest"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+)
+
+type recordingSessionManager struct {
+	core.PollMapSessionManager
+	sync.Mutex
+	notified []uint64
+}
+
+func (r *recordingSessionManager) NotifyAll(tailnetID uint64, _ ...uint64) {
+	r.Lock()
+	defer r.Unlock()
+	r.notified = append(r.notified, tailnetID)
+}
+
+func (r *recordingSessionManager) reset() []uint64 {
+	r.Lock()
+	defer r.Unlock()
+	n := r.notified
+	r.notified = nil
+	return n
+}
+
+func testDERPMap(region int, hosts ...string) tailcfg.DERPMap {
+	var nodes []*tailcfg.DERPNode
+	for _, h := range hosts {
+		nodes = append(nodes, &tailcfg.DERPNode{Name: h, RegionID: region, HostName: h})
+	}
+
+	return tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{
+			region: {RegionID: region, RegionCode: "r", Nodes: nodes},
+		},
+	}
+}
+
+func TestProber_Deprioritize(t *testing.T) {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	defaultTailnet := &domain.Tailnet{ID: util.NextID(), Name: "default"}
+	customTailnet := &domain.Tailnet{ID: util.NextID(), Name: "custom", DERPMap: domain.WrapDERPMap(testDERPMap(2, "derp3.example.com"))}
+	require.NoError(t, repository.SaveTailnet(ctx, defaultTailnet))
+	require.NoError(t, repository.SaveTailnet(ctx, customTailnet))
+
+	domain.SetDefaultDERPMap(&tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{
+			1: testDERPMap(1, "derp1.example.com", "derp2.example.com").Regions[1],
+		},
+	})
+
+	c := &config.Config{DERP: config.DERP{Prober: config.DERPProber{Enabled: true, Timeout: config.Duration(time.Second), DeprioritizeUnhealthy: true}}}
+
+	sessionManager := &recordingSessionManager{}
+	p := NewProber(c, repository, sessionManager)
+
+	var lock sync.Mutex
+	failing := map[string]bool{}
+	p.probe = func(_ context.Context, _ *tailcfg.DERPRegion, node *tailcfg.DERPNode) NodeHealth {
+		lock.Lock()
+		defer lock.Unlock()
+		if failing[node.HostName] {
+			return NodeHealth{DERPError: "connection refused"}
+		}
+		return NodeHealth{}
+	}
+
+	setFailing := func(hosts ...string) {
+		lock.Lock()
+		defer lock.Unlock()
+		failing = map[string]bool{}
+		for _, h := range hosts {
+			failing[h] = true
+		}
+	}
+
+	p.run(ctx)
+	require.Empty(t, sessionManager.reset())
+
+	dm := domain.GetDefaultDERPMap()
+	require.Same(t, &dm, p.Deprioritize(&dm))
+
+	h, ok := p.Health(&tailcfg.DERPNode{HostName: "derp3.example.com"})
+	require.True(t, ok)
+	require.True(t, h.Healthy())
+
+	setFailing("derp1.example.com")
+	p.run(ctx)
+	require.Equal(t, []uint64{defaultTailnet.ID}, sessionManager.reset())
+
+	result := p.Deprioritize(&dm)
+	require.NotEqual(t, dm.Checksum, result.Checksum)
+	require.Equal(t, "derp2.example.com", result.DERPMap.Regions[1].Nodes[0].HostName)
+	require.Equal(t, "derp1.example.com", result.DERPMap.Regions[1].Nodes[1].HostName)
+	require.False(t, result.DERPMap.Regions[1].Avoid)
+	require.Equal(t, "derp1.example.com", dm.DERPMap.Regions[1].Nodes[0].HostName)
+
+	setFailing("derp1.example.com", "derp2.example.com")
+	p.run(ctx)
+	require.Equal(t, []uint64{defaultTailnet.ID}, sessionManager.reset())
+	require.True(t, p.Deprioritize(&dm).DERPMap.Regions[1].Avoid)
+
+	setFailing("derp3.example.com")
+	p.run(ctx)
+	require.ElementsMatch(t, []uint64{defaultTailnet.ID, customTailnet.ID}, sessionManager.reset())
+	require.Same(t, &dm, p.Deprioritize(&dm))
+}
+
+func TestProbeNode_STUN(t *testing.T) {
+	addr, cleanup := stuntest.Serve(t)
+	defer cleanup()
+
+	h := probeNode(context.Background(), key.NewNode(), &tailcfg.DERPRegion{RegionID: 1}, &tailcfg.DERPNode{
+		Name:     "stun",
+		HostName: "127.0.0.1",
+		STUNOnly: true,
+		STUNPort: addr.Port,
+	})
+
+	require.True(t, h.Healthy(), h.STUNError)
+}
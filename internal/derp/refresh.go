@@ -0,0 +1,89 @@
+package derp
+
+import (
+	"context"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"go.uber.org/zap"
+	"time"
+)
+
+// StartRefresher reloads the DERP sources on the configured interval, and pushes the default DERP map
+// to the tailnets using it whenever the result has changed.
+func StartRefresher(ctx context.Context, c *config.Config, repository domain.Repository, sessionManager core.PollMapSessionManager) {
+	interval := c.DERP.RefreshInterval.Std()
+	if interval <= 0 || len(c.DERP.Sources) == 0 {
+		return
+	}
+
+	r := &refresher{
+		config:         c,
+		repository:     repository,
+		sessionManager: sessionManager,
+	}
+
+	go r.start(ctx, interval)
+}
+
+type refresher struct {
+	config         *config.Config
+	repository     domain.Repository
+	sessionManager core.PollMapSessionManager
+}
+
+func (r *refresher) start(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			r.refresh(ctx)
+		}
+	}
+}
+
+func (r *refresher) refresh(ctx context.Context) {
+	changed, err := reloadDefaultDERPMap(r.config)
+	if err != nil {
+		zap.L().Warn("not all derp sources are read successfully, keeping current derp map", zap.Error(err))
+		return
+	}
+
+	if !changed {
+		return
+	}
+
+	zap.L().Info("default derp map updated", zap.String("checksum", domain.GetDefaultDERPMap().Checksum))
+
+	tailnets, err := r.repository.ListTailnets(ctx)
+	if err != nil {
+		zap.L().Warn("unable to list tailnets", zap.Error(err))
+		return
+	}
+
+	for _, t := range tailnets {
+		if t.DERPMap.Checksum == "" {
+			r.sessionManager.NotifyAll(t.ID)
+		}
+	}
+}
+
+// reloadDefaultDERPMap loads all DERP sources and replaces the default DERP map when its checksum differs.
+// When a source can't be read, the current DERP map is kept, to avoid dropping its regions on a transient error.
+func reloadDefaultDERPMap(c *config.Config) (bool, error) {
+	derpMap, err := LoadDERPSources(c)
+	if err != nil {
+		return false, err
+	}
+
+	if domain.WrapDERPMap(*derpMap).Checksum == domain.GetDefaultDERPMap().Checksum {
+		return false, nil
+	}
+
+	domain.SetDefaultDERPMap(derpMap)
+	return true, nil
+}
@@ -0,0 +1,58 @@
+package derp
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func writeDERPMap(t *testing.T, path string, hostname string) {
+	content, err := json.Marshal(&tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{
+			1: {
+				RegionID:   1,
+				RegionCode: "nyc",
+				Nodes:      []*tailcfg.DERPNode{{Name: "1a", RegionID: 1, HostName: hostname}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+}
+
+func TestReloadDefaultDERPMap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "derpmap.json")
+	writeDERPMap(t, path, "derp1.example.com")
+
+	c := &config.Config{DERP: config.DERP{Server: config.DERPServer{Disabled: true}, Sources: []string{path}}}
+
+	changed, err := reloadDefaultDERPMap(c)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "derp1.example.com", domain.GetDefaultDERPMap().DERPMap.Regions[1].Nodes[0].HostName)
+
+	changed, err = reloadDefaultDERPMap(c)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	writeDERPMap(t, path, "derp2.example.com")
+
+	changed, err = reloadDefaultDERPMap(c)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "derp2.example.com", domain.GetDefaultDERPMap().DERPMap.Regions[1].Nodes[0].HostName)
+
+	checksum := domain.GetDefaultDERPMap().Checksum
+	require.NoError(t, os.Remove(path))
+
+	changed, err = reloadDefaultDERPMap(c)
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Equal(t, checksum, domain.GetDefaultDERPMap().Checksum)
+}
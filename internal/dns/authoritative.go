@@ -0,0 +1,253 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	mdns "github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const authoritativeAnswerTTL = 60
+
+var (
+	dnsQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ionscale",
+		Name:      "dns_queries_total",
+	}, []string{"rcode"})
+)
+
+// recordKey identifies a single record, a name can hold multiple values,
+// e.g. when the challenges for a wildcard and the apex are validated at the same time
+type recordKey struct {
+	name       string
+	recordType string
+	value      string
+}
+
+// AuthoritativeServer is an embedded, authoritative DNS server for a single zone.
+// It serves the records set by the Tailscale clients, like the TXT records of the ACME DNS-01 challenges,
+// so a delegation of the zone to ionscale is all that's needed to enable HTTPS certificates.
+// Records are kept in memory and expire after the configured ttl.
+type AuthoritativeServer struct {
+	zone       string
+	nameserver string
+	ttl        time.Duration
+	now        func() time.Time
+
+	lock    sync.RWMutex
+	serial  uint32
+	records map[recordKey]time.Time
+
+	servers []*mdns.Server
+	closed  bool
+}
+
+func NewAuthoritativeServer(zone, nameserver string, ttl time.Duration) *AuthoritativeServer {
+	return &AuthoritativeServer{
+		zone:       strings.ToLower(fqdn(zone)),
+		nameserver: strings.ToLower(fqdn(nameserver)),
+		ttl:        ttl,
+		now:        time.Now,
+		serial:     uint32(time.Now().Unix()),
+		records:    map[recordKey]time.Time{},
+	}
+}
+
+func (s *AuthoritativeServer) Zone() string {
+	return s.zone
+}
+
+func (s *AuthoritativeServer) SetRecord(_ context.Context, recordType, recordName, value string) error {
+	if recordType != "TXT" {
+		return fmt.Errorf("unsupported record type [%s], only TXT records are supported", recordType)
+	}
+
+	name := strings.ToLower(fqdn(recordName))
+	if !mdns.IsSubDomain(s.zone, name) {
+		return fmt.Errorf("record [%s] is not part of zone [%s]", recordName, s.zone)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	for k, expiresAt := range s.records {
+		if now.After(expiresAt) {
+			delete(s.records, k)
+		}
+	}
+
+	s.records[recordKey{name: name, recordType: recordType, value: value}] = now.Add(s.ttl)
+	s.serial++
+
+	return nil
+}
+
+// DeleteRecord removes the record with the given name, type and value, other records of the same name are kept
+func (s *AuthoritativeServer) DeleteRecord(_ context.Context, recordType, recordName, value string) error {
+	key := recordKey{name: strings.ToLower(fqdn(recordName)), recordType: recordType, value: value}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.records[key]; ok {
+		delete(s.records, key)
+		s.serial++
+	}
+
+	return nil
+}
+
+// Serve answers the queries received on the given connections, until Shutdown is called
+func (s *AuthoritativeServer) Serve(pc net.PacketConn, l net.Listener) error {
+	if s == nil {
+		return nil
+	}
+
+	var servers []*mdns.Server
+	if pc != nil {
+		servers = append(servers, &mdns.Server{PacketConn: pc, Handler: s})
+	}
+	if l != nil {
+		servers = append(servers, &mdns.Server{Listener: l, Handler: s})
+	}
+
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.servers = servers
+	s.lock.Unlock()
+
+	g := errgroup.Group{}
+	for _, srv := range servers {
+		srv := srv
+		g.Go(srv.ActivateAndServe)
+	}
+
+	return g.Wait()
+}
+
+func (s *AuthoritativeServer) Shutdown() error {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	s.closed = true
+	servers := s.servers
+	s.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, srv := range servers {
+		_ = srv.ShutdownContext(ctx)
+	}
+
+	return nil
+}
+
+func (s *AuthoritativeServer) ServeDNS(w mdns.ResponseWriter, req *mdns.Msg) {
+	resp := s.answer(req)
+	dnsQueries.WithLabelValues(mdns.RcodeToString[resp.Rcode]).Inc()
+
+	if err := w.WriteMsg(resp); err != nil {
+		zap.L().Named("dns").Debug("unable to write dns response", zap.Error(err))
+	}
+}
+
+func (s *AuthoritativeServer) answer(req *mdns.Msg) *mdns.Msg {
+	resp := new(mdns.Msg)
+	resp.SetReply(req)
+
+	if len(req.Question) != 1 || req.Opcode != mdns.OpcodeQuery {
+		resp.SetRcode(req, mdns.RcodeFormatError)
+		return resp
+	}
+
+	q := req.Question[0]
+	name := strings.ToLower(q.Name)
+
+	if q.Qclass != mdns.ClassINET || !mdns.IsSubDomain(s.zone, name) {
+		resp.SetRcode(req, mdns.RcodeRefused)
+		return resp
+	}
+
+	resp.Authoritative = true
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	soa := s.soa()
+
+	switch {
+	case name == s.zone && q.Qtype == mdns.TypeSOA:
+		resp.Answer = append(resp.Answer, soa)
+	case name == s.zone && q.Qtype == mdns.TypeNS:
+		resp.Answer = append(resp.Answer, &mdns.NS{Hdr: s.header(s.zone, mdns.TypeNS), Ns: s.nameserver})
+	case q.Qtype == mdns.TypeTXT:
+		for _, value := range s.lookup(name, "TXT") {
+			resp.Answer = append(resp.Answer, &mdns.TXT{Hdr: s.header(name, mdns.TypeTXT), Txt: []string{value}})
+		}
+	}
+
+	if len(resp.Answer) == 0 {
+		if name != s.zone && !s.exists(name) {
+			resp.Rcode = mdns.RcodeNameError
+		}
+		resp.Ns = append(resp.Ns, soa)
+	}
+
+	return resp
+}
+
+func (s *AuthoritativeServer) lookup(name, recordType string) []string {
+	now := s.now()
+
+	var values []string
+	for k, expiresAt := range s.records {
+		if k.name == name && k.recordType == recordType && !now.After(expiresAt) {
+			values = append(values, k.value)
+		}
+	}
+	slices.Sort(values)
+
+	return values
+}
+
+func (s *AuthoritativeServer) exists(name string) bool {
+	now := s.now()
+	for k, expiresAt := range s.records {
+		if !now.After(expiresAt) && mdns.IsSubDomain(name, k.name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *AuthoritativeServer) soa() *mdns.SOA {
+	return &mdns.SOA{
+		Hdr:     s.header(s.zone, mdns.TypeSOA),
+		Ns:      s.nameserver,
+		Mbox:    "hostmaster." + s.zone,
+		Serial:  s.serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  authoritativeAnswerTTL,
+	}
+}
+
+func (s *AuthoritativeServer) header(name string, rrtype uint16) mdns.RR_Header {
+	return mdns.RR_Header{Name: name, Rrtype: rrtype, Class: mdns.ClassINET, Ttl: authoritativeAnswerTTL}
+}
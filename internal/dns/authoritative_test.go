@@ -0,0 +1,134 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+func startAuthoritativeServer(t *testing.T, now func() time.Time) (*AuthoritativeServer, string) {
+	s := NewAuthoritativeServer("ts.example.com", "ionscale.example.com", 10*time.Minute)
+	s.now = now
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	require.NoError(t, err)
+
+	go func() { _ = s.Serve(pc, l) }()
+	t.Cleanup(func() { _ = s.Shutdown() })
+
+	return s, pc.LocalAddr().String()
+}
+
+func query(t *testing.T, network, addr, name string, qtype uint16) *mdns.Msg {
+	c := &mdns.Client{Net: network, Timeout: 2 * time.Second}
+	m := new(mdns.Msg)
+	m.SetQuestion(name, qtype)
+
+	var resp *mdns.Msg
+	require.Eventually(t, func() bool {
+		r, _, err := c.Exchange(m, addr)
+		resp = r
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	return resp
+}
+
+func TestAuthoritativeServer(t *testing.T) {
+	s, addr := startAuthoritativeServer(t, time.Now)
+
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "challenge-value"))
+	require.Error(t, s.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.example.net", "challenge-value"))
+	require.Error(t, s.SetRecord(context.Background(), "A", "laptop.acme.ts.example.com", "100.64.0.1"))
+
+	for _, n := range []string{"udp", "tcp"} {
+		resp := query(t, n, addr, "_acme-challenge.Laptop.acme.ts.example.com.", mdns.TypeTXT)
+		require.Equal(t, mdns.RcodeSuccess, resp.Rcode)
+		require.True(t, resp.Authoritative)
+		require.Len(t, resp.Answer, 1)
+		require.Equal(t, []string{"challenge-value"}, resp.Answer[0].(*mdns.TXT).Txt)
+	}
+
+	resp := query(t, "udp", addr, "ts.example.com.", mdns.TypeSOA)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "ionscale.example.com.", resp.Answer[0].(*mdns.SOA).Ns)
+
+	resp = query(t, "udp", addr, "ts.example.com.", mdns.TypeNS)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "ionscale.example.com.", resp.Answer[0].(*mdns.NS).Ns)
+
+	resp = query(t, "udp", addr, "laptop.acme.ts.example.com.", mdns.TypeTXT)
+	require.Equal(t, mdns.RcodeSuccess, resp.Rcode)
+	require.Empty(t, resp.Answer)
+
+	resp = query(t, "udp", addr, "_acme-challenge.server.acme.ts.example.com.", mdns.TypeTXT)
+	require.Equal(t, mdns.RcodeNameError, resp.Rcode)
+	require.Len(t, resp.Ns, 1)
+
+	resp = query(t, "udp", addr, "example.net.", mdns.TypeTXT)
+	require.Equal(t, mdns.RcodeRefused, resp.Rcode)
+}
+
+func TestAuthoritativeServer_RecordsExpire(t *testing.T) {
+	var elapsed atomic.Int64
+	start := time.Now()
+
+	s, addr := startAuthoritativeServer(t, func() time.Time { return start.Add(time.Duration(elapsed.Load())) })
+
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "challenge-value"))
+
+	resp := query(t, "udp", addr, "_acme-challenge.laptop.acme.ts.example.com.", mdns.TypeTXT)
+	require.Len(t, resp.Answer, 1)
+
+	elapsed.Store(int64(11 * time.Minute))
+
+	resp = query(t, "udp", addr, "_acme-challenge.laptop.acme.ts.example.com.", mdns.TypeTXT)
+	require.Equal(t, mdns.RcodeNameError, resp.Rcode)
+	require.Empty(t, resp.Answer)
+}
+
+func TestAuthoritativeServer_DeleteRecord(t *testing.T) {
+	s, addr := startAuthoritativeServer(t, time.Now)
+
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "challenge-value"))
+
+	require.NoError(t, s.DeleteRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "other-value"))
+	resp := query(t, "udp", addr, "_acme-challenge.laptop.acme.ts.example.com.", mdns.TypeTXT)
+	require.Len(t, resp.Answer, 1)
+
+	require.NoError(t, s.DeleteRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "challenge-value"))
+	resp = query(t, "udp", addr, "_acme-challenge.laptop.acme.ts.example.com.", mdns.TypeTXT)
+	require.Equal(t, mdns.RcodeNameError, resp.Rcode)
+}
+
+func TestAuthoritativeServer_MultipleValues(t *testing.T) {
+	s, addr := startAuthoritativeServer(t, time.Now)
+
+	name := "_acme-challenge.laptop.acme.ts.example.com"
+
+	// e.g. the challenges of a wildcard and the apex certificate, validated at the same time
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", name, "wildcard-value"))
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", name, "apex-value"))
+	require.NoError(t, s.SetRecord(context.Background(), "TXT", name, "apex-value"))
+
+	txt := func() [][]string {
+		var result [][]string
+		for _, rr := range query(t, "udp", addr, name+".", mdns.TypeTXT).Answer {
+			result = append(result, rr.(*mdns.TXT).Txt)
+		}
+		return result
+	}
+
+	require.Equal(t, [][]string{{"apex-value"}, {"wildcard-value"}}, txt())
+
+	require.NoError(t, s.DeleteRecord(context.Background(), "TXT", name, "apex-value"))
+	require.Equal(t, [][]string{{"wildcard-value"}}, txt())
+}
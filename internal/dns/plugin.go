@@ -108,6 +108,10 @@ func (pm *pluginManager) ensureRunning(start bool) error {
 	return nil
 }
 
+func (pm *pluginManager) Zone() string {
+	return pm.zone
+}
+
 func (pm *pluginManager) SetRecord(ctx context.Context, recordType, recordName, value string) error {
 	if err := pm.ensureRunning(false); err != nil {
 		return err
@@ -122,3 +126,17 @@ func (pm *pluginManager) SetRecord(ctx context.Context, recordType, recordName,
 
 	return err
 }
+
+func (pm *pluginManager) DeleteRecord(ctx context.Context, recordType, recordName, value string) error {
+	if err := pm.ensureRunning(false); err != nil {
+		return err
+	}
+
+	_, err := pm.instance.DeleteRecords(ctx, pm.zone, []libdns.Record{{
+		Type:  recordType,
+		Name:  libdns.RelativeName(recordName, pm.zone),
+		Value: value,
+	}})
+
+	return err
+}
@@ -16,7 +16,12 @@ import (
 	"time"
 )
 
-var factories = map[string]func() libdns.RecordSetter{
+type recordManager interface {
+	libdns.RecordSetter
+	libdns.RecordDeleter
+}
+
+var factories = map[string]func() recordManager{
 	"azure":          azureProvider,
 	"cloudflare":     cloudflareProvider,
 	"digitalocean":   digitalOceanProvider,
@@ -25,10 +30,20 @@ var factories = map[string]func() libdns.RecordSetter{
 }
 
 type Provider interface {
+	Zone() string
 	SetRecord(ctx context.Context, recordType, recordName, value string) error
+	DeleteRecord(ctx context.Context, recordType, recordName, value string) error
 }
 
 func NewProvider(config config.DNS) (Provider, error) {
+	if config.Server.ListenAddr != "" {
+		zone := config.ProviderZone()
+		if !strings.HasSuffix(config.MagicDNSSuffix, zone) {
+			return nil, fmt.Errorf("invalid MagicDNS suffix [%s], not part of zone [%s]", config.MagicDNSSuffix, zone)
+		}
+		return NewAuthoritativeServer(zone, config.Server.Nameserver, config.Server.RecordTTL.Std()), nil
+	}
+
 	p := config.Provider
 	if len(p.Zone) == 0 {
 		return nil, nil
@@ -46,6 +61,10 @@ func NewProvider(config config.DNS) (Provider, error) {
 		return nil, fmt.Errorf("invalid MagicDNS suffix [%s], not part of zone [%s]", config.MagicDNSSuffix, p.Zone)
 	}
 
+	if p.Name == "rfc2136" {
+		return newRFC2136Provider(p.Zone, p.Configuration)
+	}
+
 	factory, ok := factories[p.Name]
 	if ok {
 		return newProvider(p.Zone, p.Configuration, factory)
@@ -54,46 +73,50 @@ func NewProvider(config config.DNS) (Provider, error) {
 	return newPluginManager(p.PluginPath, fqdn(p.Zone), p.Configuration)
 }
 
-func newProvider(zone string, values json.RawMessage, factory func() libdns.RecordSetter) (Provider, error) {
+func newProvider(zone string, values json.RawMessage, factory func() recordManager) (Provider, error) {
 	p := factory()
 	if err := json.Unmarshal(values, p); err != nil {
 		return nil, err
 	}
-	return &externalProvider{zone: fqdn(zone), setter: p}, nil
+	return &externalProvider{zone: fqdn(zone), manager: p}, nil
 }
 
-func azureProvider() libdns.RecordSetter {
+func azureProvider() recordManager {
 	zap.L().Warn("Builtin azure DNS plugin is deprecated and will be removed in a future release.")
 	return &azure.Provider{}
 }
 
-func cloudflareProvider() libdns.RecordSetter {
+func cloudflareProvider() recordManager {
 	zap.L().Warn("Builtin cloudflare DNS plugin is deprecated and will be removed in a future release.")
 	return &cloudflare.Provider{}
 }
 
-func digitalOceanProvider() libdns.RecordSetter {
+func digitalOceanProvider() recordManager {
 	zap.L().Warn("Builtin digitalocean DNS plugin is deprecated and will be removed in a future release.")
 	return &digitalocean.Provider{}
 }
 
-func googleCloudDNSProvider() libdns.RecordSetter {
+func googleCloudDNSProvider() recordManager {
 	zap.L().Warn("Builtin googleclouddns DNS plugin is deprecated and will be removed in a future release.")
 	return &googleclouddns.Provider{}
 }
 
-func route53Provider() libdns.RecordSetter {
+func route53Provider() recordManager {
 	zap.L().Warn("Builtin route53 DNS plugin is deprecated and will be removed in a future release.")
 	return &route53.Provider{}
 }
 
 type externalProvider struct {
-	zone   string
-	setter libdns.RecordSetter
+	zone    string
+	manager recordManager
+}
+
+func (p *externalProvider) Zone() string {
+	return p.zone
 }
 
 func (p *externalProvider) SetRecord(ctx context.Context, recordType, recordName, value string) error {
-	_, err := p.setter.SetRecords(ctx, p.zone, []libdns.Record{{
+	_, err := p.manager.SetRecords(ctx, p.zone, []libdns.Record{{
 		Type:  recordType,
 		Name:  libdns.RelativeName(recordName, p.zone),
 		Value: value,
@@ -102,6 +125,15 @@ func (p *externalProvider) SetRecord(ctx context.Context, recordType, recordName
 	return err
 }
 
+func (p *externalProvider) DeleteRecord(ctx context.Context, recordType, recordName, value string) error {
+	_, err := p.manager.DeleteRecords(ctx, p.zone, []libdns.Record{{
+		Type:  recordType,
+		Name:  libdns.RelativeName(recordName, p.zone),
+		Value: value,
+	}})
+	return err
+}
+
 func fqdn(v string) string {
 	if strings.HasSuffix(v, ".") {
 		return v
@@ -0,0 +1,166 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	mdns "github.com/miekg/dns"
+	"net"
+	"strings"
+	"time"
+)
+
+const rfc2136RecordTTL = 60
+
+var tsigAlgorithms = map[string]string{
+	"hmac-sha1":   mdns.HmacSHA1,
+	"hmac-sha224": mdns.HmacSHA224,
+	"hmac-sha256": mdns.HmacSHA256,
+	"hmac-sha384": mdns.HmacSHA384,
+	"hmac-sha512": mdns.HmacSHA512,
+}
+
+type rfc2136Config struct {
+	Server        string `json:"server"`
+	Net           string `json:"net"`
+	TsigKeyName   string `json:"tsig_key_name"`
+	TsigSecret    string `json:"tsig_secret"`
+	TsigAlgorithm string `json:"tsig_algorithm"`
+}
+
+// rfc2136Provider manages records with RFC 2136 dynamic updates, as supported by BIND, PowerDNS, Knot and others.
+// Updates are authenticated with TSIG when a key is configured.
+type rfc2136Provider struct {
+	zone          string
+	server        string
+	net           string
+	tsigKeyName   string
+	tsigSecret    string
+	tsigAlgorithm string
+}
+
+func newRFC2136Provider(zone string, values json.RawMessage) (*rfc2136Provider, error) {
+	var c rfc2136Config
+	if len(values) != 0 {
+		if err := json.Unmarshal(values, &c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Server == "" {
+		return nil, fmt.Errorf("invalid rfc2136 configuration, server is required")
+	}
+
+	server := c.Server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+	}
+
+	switch c.Net {
+	case "", "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("invalid rfc2136 configuration, unsupported net [%s]", c.Net)
+	}
+
+	p := &rfc2136Provider{
+		zone:   strings.ToLower(fqdn(zone)),
+		server: server,
+		net:    c.Net,
+	}
+
+	if c.TsigKeyName != "" {
+		if c.TsigSecret == "" {
+			return nil, fmt.Errorf("invalid rfc2136 configuration, tsig_secret is required when tsig_key_name is set")
+		}
+
+		algorithm := strings.ToLower(strings.TrimSuffix(c.TsigAlgorithm, "."))
+		if algorithm == "" {
+			algorithm = "hmac-sha256"
+		}
+
+		a, ok := tsigAlgorithms[algorithm]
+		if !ok {
+			return nil, fmt.Errorf("invalid rfc2136 configuration, unsupported tsig algorithm [%s]", c.TsigAlgorithm)
+		}
+
+		p.tsigKeyName = strings.ToLower(fqdn(c.TsigKeyName))
+		p.tsigSecret = c.TsigSecret
+		p.tsigAlgorithm = a
+	}
+
+	return p, nil
+}
+
+func (p *rfc2136Provider) Zone() string {
+	return p.zone
+}
+
+// SetRecord replaces all records with the given name and type by a single record with the given value
+func (p *rfc2136Provider) SetRecord(ctx context.Context, recordType, recordName, value string) error {
+	rr, err := p.newRR(recordType, recordName, value)
+	if err != nil {
+		return err
+	}
+
+	m := new(mdns.Msg)
+	m.SetUpdate(p.zone)
+	m.RemoveRRset([]mdns.RR{rr})
+	m.Insert([]mdns.RR{rr})
+
+	return p.exchange(ctx, m)
+}
+
+// DeleteRecord removes the record with the given name, type and value, other records of the same name are kept
+func (p *rfc2136Provider) DeleteRecord(ctx context.Context, recordType, recordName, value string) error {
+	rr, err := p.newRR(recordType, recordName, value)
+	if err != nil {
+		return err
+	}
+
+	m := new(mdns.Msg)
+	m.SetUpdate(p.zone)
+	m.Remove([]mdns.RR{rr})
+
+	return p.exchange(ctx, m)
+}
+
+func (p *rfc2136Provider) newRR(recordType, recordName, value string) (mdns.RR, error) {
+	name := strings.ToLower(fqdn(recordName))
+	if !mdns.IsSubDomain(p.zone, name) {
+		return nil, fmt.Errorf("record [%s] is not part of zone [%s]", recordName, p.zone)
+	}
+
+	if recordType == "TXT" {
+		return &mdns.TXT{
+			Hdr: mdns.RR_Header{Name: name, Rrtype: mdns.TypeTXT, Class: mdns.ClassINET, Ttl: rfc2136RecordTTL},
+			Txt: []string{value},
+		}, nil
+	}
+
+	rr, err := mdns.NewRR(fmt.Sprintf("%s %d IN %s %s", name, rfc2136RecordTTL, recordType, value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s record [%s]: %w", recordType, recordName, err)
+	}
+
+	return rr, nil
+}
+
+func (p *rfc2136Provider) exchange(ctx context.Context, m *mdns.Msg) error {
+	c := &mdns.Client{Net: p.net, Timeout: 10 * time.Second}
+
+	if p.tsigKeyName != "" {
+		c.TsigSecret = map[string]string{p.tsigKeyName: p.tsigSecret}
+		m.SetTsig(p.tsigKeyName, p.tsigAlgorithm, 300, time.Now().Unix())
+	}
+
+	resp, _, err := c.ExchangeContext(ctx, m, p.server)
+	if err != nil {
+		return fmt.Errorf("dns update failed: %w", err)
+	}
+
+	if resp.Rcode != mdns.RcodeSuccess {
+		return fmt.Errorf("dns update failed: server responded with %s", mdns.RcodeToString[resp.Rcode])
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+const testTsigSecret = "c2VjcmV0LWtleS1mb3ItdGVzdGluZy1yZmMyMTM2"
+
+// testUpdateServer is a minimal in-process DNS server applying RFC 2136 updates to an in-memory zone
+type testUpdateServer struct {
+	sync.Mutex
+	records map[string][]string
+}
+
+func (s *testUpdateServer) ServeDNS(w mdns.ResponseWriter, req *mdns.Msg) {
+	resp := new(mdns.Msg)
+	resp.SetReply(req)
+
+	tsig := req.IsTsig()
+	if tsig == nil || w.TsigStatus() != nil {
+		resp.Rcode = mdns.RcodeNotAuth
+		_ = w.WriteMsg(resp)
+		return
+	}
+
+	s.Lock()
+	for _, rr := range req.Ns {
+		h := rr.Header()
+		key := h.Name + "/" + mdns.TypeToString[h.Rrtype]
+		switch h.Class {
+		case mdns.ClassANY:
+			delete(s.records, key)
+		case mdns.ClassNONE:
+			var kept []string
+			for _, v := range s.records[key] {
+				if v != rr.(*mdns.TXT).Txt[0] {
+					kept = append(kept, v)
+				}
+			}
+			s.records[key] = kept
+		case mdns.ClassINET:
+			s.records[key] = append(s.records[key], rr.(*mdns.TXT).Txt[0])
+		}
+	}
+	s.Unlock()
+
+	resp.SetTsig(tsig.Hdr.Name, tsig.Algorithm, 300, time.Now().Unix())
+	_ = w.WriteMsg(resp)
+}
+
+func (s *testUpdateServer) get(key string) []string {
+	s.Lock()
+	defer s.Unlock()
+	return s.records[key]
+}
+
+func startTestUpdateServer(t *testing.T) (*testUpdateServer, string) {
+	handler := &testUpdateServer{records: map[string][]string{}}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	srv := &mdns.Server{
+		PacketConn:        pc,
+		Handler:           handler,
+		TsigSecret:        map[string]string{"ionscale.": testTsigSecret},
+		NotifyStartedFunc: func() { close(started) },
+		MsgAcceptFunc:     func(mdns.Header) mdns.MsgAcceptAction { return mdns.MsgAccept },
+	}
+
+	go func() { _ = srv.ActivateAndServe() }()
+	t.Cleanup(func() { _ = srv.Shutdown() })
+	<-started
+
+	return handler, pc.LocalAddr().String()
+}
+
+func newTestRFC2136Provider(t *testing.T, addr, secret string) *rfc2136Provider {
+	values, err := json.Marshal(map[string]string{
+		"server":        addr,
+		"tsig_key_name": "ionscale",
+		"tsig_secret":   secret,
+	})
+	require.NoError(t, err)
+
+	p, err := newRFC2136Provider("ts.example.com", values)
+	require.NoError(t, err)
+	return p
+}
+
+func TestRFC2136Provider_SetAndDeleteRecord(t *testing.T) {
+	server, addr := startTestUpdateServer(t)
+	p := newTestRFC2136Provider(t, addr, testTsigSecret)
+
+	key := "_acme-challenge.laptop.acme.ts.example.com./TXT"
+
+	require.NoError(t, p.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "first"))
+	require.Equal(t, []string{"first"}, server.get(key))
+
+	require.NoError(t, p.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "second"))
+	require.Equal(t, []string{"second"}, server.get(key))
+
+	require.NoError(t, p.DeleteRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "second"))
+	require.Empty(t, server.get(key))
+
+	require.Error(t, p.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.example.net", "value"))
+}
+
+func TestRFC2136Provider_InvalidTsigSecret(t *testing.T) {
+	server, addr := startTestUpdateServer(t)
+	p := newTestRFC2136Provider(t, addr, "d3JvbmctdGVzdC1zZWNyZXQ=")
+
+	require.Error(t, p.SetRecord(context.Background(), "TXT", "_acme-challenge.laptop.acme.ts.example.com", "value"))
+	require.Empty(t, server.get("_acme-challenge.laptop.acme.ts.example.com./TXT"))
+}
+
+func TestNewRFC2136Provider_Configuration(t *testing.T) {
+	p, err := newRFC2136Provider("ts.example.com", json.RawMessage(`{"server": "ns1.example.com"}`))
+	require.NoError(t, err)
+	require.Equal(t, "ns1.example.com:53", p.server)
+
+	p, err = newRFC2136Provider("ts.example.com", json.RawMessage(`{"server": "::1"}`))
+	require.NoError(t, err)
+	require.Equal(t, "[::1]:53", p.server)
+
+	_, err = newRFC2136Provider("ts.example.com", json.RawMessage(`{}`))
+	require.Error(t, err)
+
+	_, err = newRFC2136Provider("ts.example.com", json.RawMessage(`{"server": "ns1.example.com", "tsig_key_name": "ionscale"}`))
+	require.Error(t, err)
+
+	_, err = newRFC2136Provider("ts.example.com", json.RawMessage(`{"server": "ns1.example.com", "tsig_key_name": "ionscale", "tsig_secret": "c2VjcmV0", "tsig_algorithm": "hmac-md5"}`))
+	require.Error(t, err)
+}
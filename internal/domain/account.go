@@ -10,22 +10,24 @@ import (
 
 type AccountRepository interface {
 	GetAccount(ctx context.Context, accountID uint64) (*Account, error)
-	GetOrCreateAccount(ctx context.Context, externalID, loginName string) (*Account, bool, error)
+	GetOrCreateAccount(ctx context.Context, provider, externalID, loginName string) (*Account, bool, error)
 	SetAccountLastAuthenticated(ctx context.Context, accountID uint64) error
 }
 
+// Account is an identity authenticated by an auth provider, uniquely identified by the name of the provider and the subject
 type Account struct {
 	ID         uint64 `gorm:"primary_key"`
+	Provider   string
 	ExternalID string
 	LoginName  string
 }
 
-func (r *repository) GetOrCreateAccount(ctx context.Context, externalID, loginName string) (*Account, bool, error) {
+func (r *repository) GetOrCreateAccount(ctx context.Context, provider, externalID, loginName string) (*Account, bool, error) {
 	account := &Account{}
 	id := util.NextID()
 
 	tx := r.withContext(ctx).
-		Where(Account{ExternalID: externalID}).
+		Where(Account{Provider: provider, ExternalID: externalID}).
 		Attrs(Account{ID: id, LoginName: loginName}).
 		FirstOrCreate(account)
 
@@ -24,6 +24,7 @@ const (
 	AutoGroupTagged    = "autogroup:tagged"
 	AutoGroupInternet  = "autogroup:internet"
 	AutoGroupDangerAll = "autogroup:danger-all"
+	AutoGroupShared    = "autogroup:shared"
 )
 
 type AutoApprovers struct {
@@ -46,10 +47,17 @@ func (a *ACLPolicy) Equal(x *ACLPolicy) bool {
 }
 
 func (a ACLPolicy) FindAutoApprovedIPs(routableIPs []netip.Prefix, tags []string, u *User) []netip.Prefix {
-	if a.AutoApprovers == nil || len(routableIPs) == 0 {
+	connectorRoutes := a.taggedAppConnectorRoutes(tags)
+
+	if (a.AutoApprovers == nil && len(connectorRoutes) == 0) || len(routableIPs) == 0 {
 		return nil
 	}
 
+	autoApprovers := a.AutoApprovers
+	if autoApprovers == nil {
+		autoApprovers = &ionscale.ACLAutoApprovers{}
+	}
+
 	matches := func(values []string) bool {
 		for _, alias := range values {
 			if alias == u.Name {
@@ -86,23 +94,26 @@ func (a ACLPolicy) FindAutoApprovedIPs(routableIPs []netip.Prefix, tags []string
 	}
 
 	var autoApprovedIPs []netip.Prefix
-	for route, autoApprovers := range a.AutoApprovers.Routes {
+	for route, approvers := range autoApprovers.Routes {
 		candidate, err := netip.ParsePrefix(route)
 		if err != nil {
 			return nil
 		}
 
-		if matches(autoApprovers) {
+		if matches(approvers) {
 			autoApprovedIPs = append(autoApprovedIPs, candidate)
 		}
 	}
 
 	var result []netip.Prefix
 	for _, c := range routableIPs {
-		if c.Bits() == 0 && matches(a.AutoApprovers.ExitNode) {
+		if c.Bits() == 0 && matches(autoApprovers.ExitNode) {
 			result = append(result, c)
 		}
-		if isAutoApproved(c, autoApprovedIPs) {
+		// app connectors get their configured routes approved automatically, the host routes they learn
+		// when resolving their domains still require an auto approver, like any other route
+		isConnectorRoute := c.Bits() != 0 && isAutoApproved(c, connectorRoutes)
+		if isAutoApproved(c, autoApprovedIPs) || isConnectorRoute {
 			result = append(result, c)
 		}
 	}
@@ -143,49 +154,75 @@ func (a ACLPolicy) isTagOwner(tag string, p *User) bool {
 func (a ACLPolicy) NodeCapabilities(m *Machine) []tailcfg.NodeCapability {
 	var result = &StringSet{}
 
-	matches := func(targets []string) bool {
-		for _, alias := range targets {
-			if alias == "*" {
-				return true
-			}
+	for _, nodeAddr := range a.NodeAttrs {
+		if a.isNodeAttrTarget(nodeAddr.Target, m) {
+			result.Add(nodeAddr.Attr...)
+		}
+	}
 
-			if strings.Contains(alias, "@") && !m.HasTags() && m.HasUser(alias) {
-				return true
-			}
+	items := result.Items()
+	caps := make([]tailcfg.NodeCapability, len(items))
+	for i, c := range items {
+		caps[i] = tailcfg.NodeCapability(c)
+	}
 
-			if strings.HasPrefix(alias, "tag:") && m.HasTag(alias) {
-				return true
-			}
+	return caps
+}
 
-			if strings.HasPrefix(alias, "group:") && !m.HasTags() {
-				for _, u := range a.Groups[alias] {
-					if m.HasUser(u) {
-						return true
-					}
-				}
-			}
+// NodeCapMap returns the node attributes of a machine, with the values of all matching app entries merged per capability
+func (a ACLPolicy) NodeCapMap(m *Machine) tailcfg.NodeCapMap {
+	var result = make(tailcfg.NodeCapMap)
 
-			if (alias == AutoGroupMember || alias == AutoGroupMembers) && !m.HasTags() {
-				return true
+	for _, nodeAttr := range a.NodeAttrs {
+		if !a.isNodeAttrTarget(nodeAttr.Target, m) {
+			continue
+		}
+
+		for _, c := range nodeAttr.Attr {
+			if _, ok := result[tailcfg.NodeCapability(c)]; !ok {
+				result[tailcfg.NodeCapability(c)] = []tailcfg.RawMessage{}
 			}
 		}
 
-		return false
+		for c, values := range nodeAttr.App {
+			if _, ok := result[c]; !ok {
+				result[c] = []tailcfg.RawMessage{}
+			}
+			result[c] = append(result[c], values...)
+		}
 	}
 
-	for _, nodeAddr := range a.NodeAttrs {
-		if matches(nodeAddr.Target) {
-			result.Add(nodeAddr.Attr...)
+	return result
+}
+
+func (a ACLPolicy) isNodeAttrTarget(targets []string, m *Machine) bool {
+	for _, alias := range targets {
+		if alias == "*" {
+			return true
 		}
-	}
 
-	items := result.Items()
-	caps := make([]tailcfg.NodeCapability, len(items))
-	for i, c := range items {
-		caps[i] = tailcfg.NodeCapability(c)
+		if strings.Contains(alias, "@") && !m.HasTags() && m.HasUser(alias) {
+			return true
+		}
+
+		if strings.HasPrefix(alias, "tag:") && m.HasTag(alias) {
+			return true
+		}
+
+		if strings.HasPrefix(alias, "group:") && !m.HasTags() {
+			for _, u := range a.Groups[alias] {
+				if m.HasUser(u) {
+					return true
+				}
+			}
+		}
+
+		if (alias == AutoGroupMember || alias == AutoGroupMembers) && !m.HasTags() {
+			return true
+		}
 	}
 
-	return caps
+	return false
 }
 
 func (a ACLPolicy) parsePortRanges(s string) ([]tailcfg.PortRange, error) {
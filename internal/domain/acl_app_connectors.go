@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+	"tailscale.com/types/appctype"
+)
+
+const AppConnectorsCapability = "tailscale.com/app-connectors"
+
+// AppConnectorAttrs returns the app connectors the given machine serves,
+// a machine serves an app connector when it has one of the connector tags, or when '*' is used as connector.
+func (a ACLPolicy) AppConnectorAttrs(m *Machine) []appctype.AppConnectorAttr {
+	var result []appctype.AppConnectorAttr
+
+	for _, c := range a.AppConnectors {
+		if slices.Contains(c.Connectors, "*") || hasAnyTag(c.Connectors, m.Tags) {
+			result = append(result, appctype.AppConnectorAttr{
+				Name:       c.Name,
+				Domains:    c.Domains,
+				Routes:     c.Routes,
+				Connectors: c.Connectors,
+			})
+		}
+	}
+
+	return result
+}
+
+// AppConnectorDomains returns the domains served by the app connectors, with wildcards removed
+// so they can be used as DNS routes.
+func AppConnectorDomains(connectors []appctype.AppConnectorAttr) []string {
+	var result = &StringSet{}
+	for _, c := range connectors {
+		for _, d := range c.Domains {
+			result.Add(strings.TrimPrefix(d, "*."))
+		}
+	}
+	return result.Items()
+}
+
+// taggedAppConnectorRoutes returns the routes of the app connectors selected by the tags.
+// Only connectors selected by tag get their routes approved automatically.
+func (a ACLPolicy) taggedAppConnectorRoutes(tags []string) []netip.Prefix {
+	var routes []netip.Prefix
+	for _, c := range a.AppConnectors {
+		if hasAnyTag(c.Connectors, tags) {
+			routes = append(routes, c.Routes...)
+		}
+	}
+	return routes
+}
+
+func hasAnyTag(aliases []string, tags []string) bool {
+	for _, alias := range aliases {
+		if strings.HasPrefix(alias, "tag:") && slices.Contains(tags, alias) {
+			return true
+		}
+	}
+	return false
+}
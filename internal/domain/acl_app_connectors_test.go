@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"tailscale.com/types/appctype"
+	"testing"
+)
+
+func TestACLPolicy_AppConnectorAttrs(t *testing.T) {
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			AppConnectors: []ionscale.ACLAppConnector{
+				{
+					Name:       "github",
+					Connectors: []string{"tag:github-connector"},
+					Domains:    []string{"github.com", "*.github.com"},
+				},
+				{
+					Name:       "internal",
+					Connectors: []string{"*"},
+					Domains:    []string{"internal.example.com"},
+				},
+			},
+		},
+	}
+
+	github := appctype.AppConnectorAttr{
+		Name:       "github",
+		Connectors: []string{"tag:github-connector"},
+		Domains:    []string{"github.com", "*.github.com"},
+	}
+	internal := appctype.AppConnectorAttr{
+		Name:       "internal",
+		Connectors: []string{"*"},
+		Domains:    []string{"internal.example.com"},
+	}
+
+	assert.Equal(t, []appctype.AppConnectorAttr{github, internal}, policy.AppConnectorAttrs(createMachine("john@example.com", "tag:github-connector")))
+	assert.Equal(t, []appctype.AppConnectorAttr{internal}, policy.AppConnectorAttrs(createMachine("john@example.com", "tag:web")))
+	assert.Equal(t, []string{"github.com", "internal.example.com"}, AppConnectorDomains([]appctype.AppConnectorAttr{github, internal}))
+}
+
+func TestACLPolicy_FindAutoApprovedIPsForAppConnectors(t *testing.T) {
+	route1 := netip.MustParsePrefix("140.82.112.3/32")
+	route2 := netip.MustParsePrefix("2606:50c0:8000::154/128")
+	route3 := netip.MustParsePrefix("10.0.0.0/24")
+	peer := netip.MustParsePrefix("100.64.0.2/32")
+	exit := netip.MustParsePrefix("0.0.0.0/0")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			AppConnectors: []ionscale.ACLAppConnector{
+				{
+					Name:       "github",
+					Connectors: []string{"tag:github-connector"},
+					Domains:    []string{"github.com"},
+				},
+				{
+					Name:       "any",
+					Connectors: []string{"*"},
+					Domains:    []string{"example.com"},
+				},
+			},
+		},
+	}
+
+	user := &User{Name: "john@example.com"}
+
+	// without auto approvers, none of the learned routes are approved, not even host routes
+	assert.Nil(t, policy.FindAutoApprovedIPs([]netip.Prefix{route1, route2, route3, peer, exit}, []string{"tag:github-connector"}, user))
+	assert.Nil(t, policy.FindAutoApprovedIPs([]netip.Prefix{netip.MustParsePrefix("0.0.0.0/1"), netip.MustParsePrefix("128.0.0.0/1")}, []string{"tag:github-connector"}, user))
+
+	// learned routes are approved through the auto approvers
+	policy.AutoApprovers = &ionscale.ACLAutoApprovers{
+		Routes: map[string][]string{
+			"140.82.112.0/20":     {"tag:github-connector"},
+			"2606:50c0:8000::/36": {"tag:github-connector"},
+		},
+	}
+	assert.Equal(t, []netip.Prefix{route1, route2}, policy.FindAutoApprovedIPs([]netip.Prefix{route1, route2, route3, peer, exit}, []string{"tag:github-connector"}, user))
+	assert.Nil(t, policy.FindAutoApprovedIPs([]netip.Prefix{route1, route2}, []string{"tag:web"}, user))
+	assert.Nil(t, policy.FindAutoApprovedIPs([]netip.Prefix{route1, route2}, nil, user))
+}
+
+func TestACLPolicy_FindAutoApprovedIPsForAppConnectorsWithPeerAddress(t *testing.T) {
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			AppConnectors: []ionscale.ACLAppConnector{
+				{
+					Name:       "github",
+					Connectors: []string{"tag:github-connector"},
+					Domains:    []string{"github.com"},
+				},
+			},
+			AutoApprovers: &ionscale.ACLAutoApprovers{
+				Routes: map[string][]string{"140.82.112.0/20": {"tag:github-connector"}},
+			},
+		},
+	}
+
+	user := &User{Name: "john@example.com"}
+
+	// a connector can't take over the traffic for the address of another node
+	peer := netip.MustParsePrefix("100.64.0.2/32")
+	peer6 := netip.MustParsePrefix("fd7a:115c:a1e0::2/128")
+	github := netip.MustParsePrefix("140.82.112.3/32")
+
+	assert.Equal(t, []netip.Prefix{github}, policy.FindAutoApprovedIPs([]netip.Prefix{peer, peer6, github}, []string{"tag:github-connector"}, user))
+}
+
+func TestACLPolicy_FindAutoApprovedIPsForAppConnectorsWithRoutes(t *testing.T) {
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			AppConnectors: []ionscale.ACLAppConnector{
+				{
+					Name:       "aws",
+					Connectors: []string{"tag:aws-connector"},
+					Routes:     []netip.Prefix{netip.MustParsePrefix("3.5.0.0/16")},
+				},
+			},
+		},
+	}
+
+	user := &User{Name: "john@example.com"}
+
+	inside := netip.MustParsePrefix("3.5.140.0/22")
+	host := netip.MustParsePrefix("3.5.12.1/32")
+
+	assert.Equal(t, []netip.Prefix{inside, host}, policy.FindAutoApprovedIPs([]netip.Prefix{inside, host}, []string{"tag:aws-connector"}, user))
+
+	// without domains, host routes outside the configured routes are not approved
+	assert.Nil(t, policy.FindAutoApprovedIPs([]netip.Prefix{netip.MustParsePrefix("3.0.0.0/8"), netip.MustParsePrefix("140.82.112.3/32"), netip.MustParsePrefix("10.0.0.0/8")}, []string{"tag:aws-connector"}, user))
+}
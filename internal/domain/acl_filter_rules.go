@@ -9,7 +9,11 @@ import (
 )
 
 func (a ACLPolicy) IsValidPeer(src *Machine, dest *Machine) bool {
-	if !src.HasTags() && !dest.HasTags() && dest.HasUser(src.User.Name) {
+	if src.IsIsolated() || dest.IsIsolated() {
+		return false
+	}
+
+	if !src.Sharee && !src.HasTags() && !dest.HasTags() && dest.HasUser(src.User.Name) {
 		return true
 	}
 
@@ -32,6 +36,10 @@ func (a ACLPolicy) IsValidPeer(src *Machine, dest *Machine) bool {
 	}
 
 	for _, grant := range a.Grants {
+		if !isViaRouter(grant, dest) {
+			continue
+		}
+
 		selfIps, otherIps := a.translateDestinationAliasesToMachineIPs(grant.Destination, dest)
 		if len(selfIps) != 0 {
 			for _, alias := range grant.Source {
@@ -73,6 +81,10 @@ func (a ACLPolicy) IsValidPeer(src *Machine, dest *Machine) bool {
 func (a ACLPolicy) BuildFilterRules(peers []Machine, dst *Machine) []tailcfg.FilterRule {
 	var rules = make([]tailcfg.FilterRule, 0)
 
+	if dst.IsIsolated() {
+		return rules
+	}
+
 	matchSourceAndAppendRule := func(rules []tailcfg.FilterRule, aliases []string, preparedRules []tailcfg.FilterRule, u *User) []tailcfg.FilterRule {
 		if len(preparedRules) == 0 {
 			return rules
@@ -81,6 +93,9 @@ func (a ACLPolicy) BuildFilterRules(peers []Machine, dst *Machine) []tailcfg.Fil
 		var allSrcIPsSet = &StringSet{}
 		for _, alias := range aliases {
 			for _, peer := range peers {
+				if peer.IsIsolated() {
+					continue
+				}
 				allSrcIPsSet.Add(a.translateSourceAliasToMachineIPs(alias, &peer, u)...)
 			}
 		}
@@ -169,6 +184,10 @@ func (a ACLPolicy) prepareFilterRulesFromACL(candidate *Machine, acl ionscale.AC
 }
 
 func (a ACLPolicy) prepareFilterRulesFromGrant(candidate *Machine, grant ionscale.ACLGrant) ([]tailcfg.FilterRule, []tailcfg.FilterRule) {
+	if !isViaRouter(grant, candidate) {
+		return nil, nil
+	}
+
 	selfIPs, otherIPs := a.translateDestinationAliasesToMachineIPs(grant.Destination, candidate)
 
 	var selfFilterRules []tailcfg.FilterRule
@@ -220,6 +239,11 @@ func (a ACLPolicy) prepareFilterRulesFromGrant(candidate *Machine, grant ionscal
 	return selfFilterRules, otherFilterRules
 }
 
+// isViaRouter checks if a machine is a router for a grant, grants with via only apply to the routers having one of the via tags
+func isViaRouter(grant ionscale.ACLGrant, m *Machine) bool {
+	return len(grant.Via) == 0 || hasAnyTag(grant.Via, m.Tags)
+}
+
 func appGrantDstIpsToPrefixes(m *Machine, self []string, other []string) ([]netip.Prefix, []netip.Prefix) {
 	translate := func(ips []string) []netip.Prefix {
 		var prefixes []netip.Prefix
@@ -337,6 +361,14 @@ func (a ACLPolicy) translateSourceAliasToMachineIPs(alias string, m *Machine, u
 		return make([]string, 0)
 	}
 
+	// machines of other tailnets a machine is shared with are only matched by autogroup:shared
+	if m.Sharee {
+		if u == nil && alias == AutoGroupShared {
+			return m.IPs()
+		}
+		return []string{}
+	}
+
 	if u != nil && m.HasTags() {
 		return []string{}
 	}
@@ -145,6 +145,45 @@ func TestACLPolicy_NodeAttributesWithAutoGroupMember(t *testing.T) {
 	assert.Equal(t, expectedAttrs, actualAttrs)
 }
 
+func TestACLPolicy_NodeCapMapWithValues(t *testing.T) {
+	p1 := createMachine("john@example.com", "tag:web")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			NodeAttrs: []ionscale.ACLNodeAttrGrant{
+				{
+					Target: []string{"*"},
+					Attr:   []string{"attr1"},
+					App: tailcfg.NodeCapMap{
+						"example.com/cap/config": []tailcfg.RawMessage{`{"a":1}`},
+					},
+				},
+				{
+					Target: []string{"tag:web"},
+					App: tailcfg.NodeCapMap{
+						"example.com/cap/config": []tailcfg.RawMessage{`{"b":2}`},
+						"example.com/cap/flag":   []tailcfg.RawMessage{},
+					},
+				},
+				{
+					Target: []string{"tag:db"},
+					App: tailcfg.NodeCapMap{
+						"example.com/cap/config": []tailcfg.RawMessage{`{"c":3}`},
+					},
+				},
+			},
+		},
+	}
+
+	expected := tailcfg.NodeCapMap{
+		"attr1":                  []tailcfg.RawMessage{},
+		"example.com/cap/config": []tailcfg.RawMessage{`{"a":1}`, `{"b":2}`},
+		"example.com/cap/flag":   []tailcfg.RawMessage{},
+	}
+
+	assert.Equal(t, expected, policy.NodeCapMap(p1))
+}
+
 func TestACLPolicy_BuildFilterRulesEmptyACL(t *testing.T) {
 	p1 := createMachine("john@example.com")
 	p2 := createMachine("jane@example.com")
@@ -710,6 +749,87 @@ func TestACLPolicy_BuildFilterRulesAutogroupDangerAll(t *testing.T) {
 	assert.Equal(t, expectedRules, actualRules)
 }
 
+func TestACLPolicy_BuildFilterRulesAutogroupShared(t *testing.T) {
+	p1 := createMachine("jane@example.com")
+	p2 := createMachine("nick@example.com")
+	p2.Sharee = true
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			ACLs: []ionscale.ACLEntry{
+				{
+					Action:      "accept",
+					Source:      []string{"*"},
+					Destination: []string{"*:22"},
+				},
+				{
+					Action:      "accept",
+					Source:      []string{"autogroup:shared"},
+					Destination: []string{"*:80"},
+				},
+			},
+		},
+	}
+
+	dst := createMachine("john@example.com")
+
+	actualRules := policy.BuildFilterRules([]Machine{*p1, *p2}, dst)
+	expectedRules := []tailcfg.FilterRule{
+		{
+			SrcIPs: expectedSourceIPs(p1),
+			DstPorts: []tailcfg.NetPortRange{
+				{
+					IP: "*",
+					Ports: tailcfg.PortRange{
+						First: 22,
+						Last:  22,
+					},
+				},
+			},
+		},
+		{
+			SrcIPs: expectedSourceIPs(p2),
+			DstPorts: []tailcfg.NetPortRange{
+				{
+					IP: "*",
+					Ports: tailcfg.PortRange{
+						First: 80,
+						Last:  80,
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expectedRules, actualRules)
+}
+
+func TestWithAutogroupShared(t *testing.T) {
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			ACLs: []ionscale.ACLEntry{
+				{
+					Action:      "accept",
+					Source:      []string{"*"},
+					Destination: []string{"*:*"},
+				},
+				{
+					Action:      "accept",
+					Source:      []string{"autogroup:shared"},
+					Destination: []string{"tag:web:*"},
+				},
+			},
+		},
+	}
+
+	src := createMachine("john@example.com")
+	src.Sharee = true
+
+	assert.True(t, policy.IsValidPeer(src, createMachine("jane@example.com", "tag:web")))
+	assert.False(t, policy.IsValidPeer(src, createMachine("jane@example.com", "tag:ci")))
+	assert.False(t, policy.IsValidPeer(src, createMachine("john@example.com")))
+}
+
 func TestWithUser(t *testing.T) {
 	policy := ACLPolicy{
 		ionscale.ACLPolicy{
@@ -1122,3 +1242,226 @@ func TestACLPolicy_BuildFilterRulesWithAppGrants(t *testing.T) {
 
 	assert.Equal(t, expectedRules, actualRules)
 }
+
+func TestACLPolicy_SelectViaRoutesWithOverlappingPrefixes(t *testing.T) {
+	ranges, err := tailcfg.ParseProtoPortRanges([]string{"*"})
+	require.NoError(t, err)
+
+	wide := netip.MustParsePrefix("10.0.0.0/8")
+	narrow := netip.MustParsePrefix("10.1.0.0/16")
+
+	john := createMachine("john@example.com")
+	jane := createMachine("jane@example.com")
+
+	routerEU := createMachine("john@example.com", "tag:router-eu")
+	routerEU.ID = 1
+	routerEU.AllowIPs = []netip.Prefix{wide}
+
+	routerUS := createMachine("john@example.com", "tag:router-us")
+	routerUS.ID = 2
+	routerUS.AllowIPs = []netip.Prefix{wide, narrow}
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Groups: map[string][]string{
+				"group:engineering": {"john@example.com"},
+				"group:sales":       {"jane@example.com"},
+			},
+			Grants: []ionscale.ACLGrant{
+				{
+					Source:      []string{"group:engineering"},
+					Destination: []string{"10.0.0.0/8"},
+					IP:          ranges,
+					Via:         []string{"tag:router-eu"},
+				},
+				{
+					Source:      []string{"group:sales"},
+					Destination: []string{"10.1.0.0/16"},
+					IP:          ranges,
+					Via:         []string{"tag:router-us"},
+				},
+			},
+		},
+	}
+
+	routers := []Machine{*routerEU, *routerUS}
+	primaries := map[netip.Prefix]uint64{wide: routerUS.ID, narrow: routerUS.ID}
+	online := func(m *Machine) bool { return true }
+
+	// engineering reaches both prefixes through the eu router, which only advertises the wide prefix
+	assert.Equal(t, map[netip.Prefix]uint64{wide: routerEU.ID, narrow: 0}, policy.SelectViaRoutes(john, routers, primaries, online))
+
+	// sales reaches the narrow prefix through the us router, the wide prefix is not restricted
+	assert.Equal(t, map[netip.Prefix]uint64{narrow: routerUS.ID}, policy.SelectViaRoutes(jane, routers, primaries, online))
+}
+
+func TestACLPolicy_SelectViaRoutesPrefersPrimaryAndOnlineRouters(t *testing.T) {
+	route := netip.MustParsePrefix("10.0.0.0/8")
+
+	john := createMachine("john@example.com")
+
+	router1 := createMachine("john@example.com", "tag:router")
+	router1.ID = 1
+	router1.AllowIPs = []netip.Prefix{route}
+
+	router2 := createMachine("john@example.com", "tag:router")
+	router2.ID = 2
+	router2.AllowIPs = []netip.Prefix{route}
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Grants: []ionscale.ACLGrant{
+				{
+					Source:      []string{"john@example.com"},
+					Destination: []string{"10.0.0.0/8"},
+					Via:         []string{"tag:router"},
+				},
+			},
+		},
+	}
+
+	routers := []Machine{*router1, *router2}
+
+	actual := policy.SelectViaRoutes(john, routers, map[netip.Prefix]uint64{route: router2.ID}, func(m *Machine) bool { return true })
+	assert.Equal(t, router2.ID, actual[route])
+
+	actual = policy.SelectViaRoutes(john, routers, map[netip.Prefix]uint64{}, func(m *Machine) bool { return m.ID == router2.ID })
+	assert.Equal(t, router2.ID, actual[route])
+}
+
+func TestACLPolicy_BuildFilterRulesWithViaGrants(t *testing.T) {
+	ranges, err := tailcfg.ParseProtoPortRanges([]string{"*"})
+	require.NoError(t, err)
+
+	route := netip.MustParsePrefix("10.0.0.0/8")
+
+	john := createMachine("john@example.com")
+
+	routerEU := createMachine("john@example.com", "tag:router-eu")
+	routerEU.AllowIPs = []netip.Prefix{route}
+
+	routerUS := createMachine("john@example.com", "tag:router-us")
+	routerUS.AllowIPs = []netip.Prefix{route}
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Grants: []ionscale.ACLGrant{
+				{
+					Source:      []string{"john@example.com"},
+					Destination: []string{"10.0.0.0/8"},
+					IP:          ranges,
+					Via:         []string{"tag:router-eu"},
+				},
+			},
+		},
+	}
+
+	expectedRules := []tailcfg.FilterRule{
+		{
+			SrcIPs: john.IPs(),
+			DstPorts: []tailcfg.NetPortRange{
+				{
+					IP: route.String(),
+					Ports: tailcfg.PortRange{
+						First: 0,
+						Last:  65535,
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expectedRules, policy.BuildFilterRules([]Machine{*john}, routerEU))
+	assert.Empty(t, policy.BuildFilterRules([]Machine{*john}, routerUS))
+	assert.True(t, policy.IsValidPeer(john, routerEU))
+	assert.False(t, policy.IsValidPeer(john, routerUS))
+}
+
+func TestACLPolicy_IsExitNodeAllowedVia(t *testing.T) {
+	john := createMachine("john@example.com")
+	jane := createMachine("jane@example.com")
+	exitEU := createMachine("john@example.com", "tag:exit-eu")
+	exitUS := createMachine("john@example.com", "tag:exit-us")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Grants: []ionscale.ACLGrant{
+				{
+					Source:      []string{"john@example.com"},
+					Destination: []string{"autogroup:internet"},
+					Via:         []string{"tag:exit-eu"},
+				},
+			},
+		},
+	}
+
+	assert.True(t, policy.IsExitNodeAllowedVia(john, exitEU))
+	assert.False(t, policy.IsExitNodeAllowedVia(john, exitUS))
+	assert.True(t, policy.IsExitNodeAllowedVia(jane, exitUS))
+}
+
+func TestACLPolicy_QuarantinedMachines(t *testing.T) {
+	ranges, err := tailcfg.ParseProtoPortRanges([]string{"*"})
+	require.NoError(t, err)
+
+	p1 := createMachine("john@example.com")
+	p2 := createMachine("jane@example.com")
+	p2.Quarantined = true
+
+	dst := createMachine("john@example.com")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Grants: []ionscale.ACLGrant{
+				{
+					Source:      []string{"*"},
+					Destination: []string{"*"},
+					IP:          ranges,
+				},
+			},
+		},
+	}
+
+	assert.True(t, policy.IsValidPeer(p1, dst))
+	assert.False(t, policy.IsValidPeer(p2, dst))
+	assert.False(t, policy.IsValidPeer(dst, p2))
+
+	actualRules := policy.BuildFilterRules([]Machine{*p1, *p2}, dst)
+	assert.Len(t, actualRules, 1)
+	assert.Equal(t, expectedSourceIPs(p1), actualRules[0].SrcIPs)
+
+	assert.Empty(t, policy.BuildFilterRules([]Machine{*p1, *dst}, p2))
+}
+
+func TestACLPolicy_MachinesOfSuspendedUsers(t *testing.T) {
+	p1 := createMachine("john@example.com")
+	p1.User.Suspended = true
+
+	p2 := createMachine("john@example.com", "tag:server")
+	p2.User.Suspended = true
+
+	dst := createMachine("jane@example.com")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			ACLs: []ionscale.ACLEntry{
+				{
+					Action:      "accept",
+					Source:      []string{"*"},
+					Destination: []string{"*:*"},
+				},
+			},
+		},
+	}
+
+	assert.False(t, policy.IsValidPeer(p1, dst))
+	assert.False(t, policy.IsValidPeer(dst, p1))
+	assert.Empty(t, policy.BuildFilterRules([]Machine{*dst}, p1))
+
+	// tagged machines are not owned by the user, and are not affected by the suspension
+	assert.True(t, policy.IsValidPeer(p2, dst))
+
+	actualRules := policy.BuildFilterRules([]Machine{*p1, *p2}, dst)
+	assert.Len(t, actualRules, 1)
+	assert.Equal(t, expectedSourceIPs(p2), actualRules[0].SrcIPs)
+}
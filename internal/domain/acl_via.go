@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"cmp"
+	"net/netip"
+	"slices"
+)
+
+// ViaTags returns the tags of the routers a machine has to use to reach a route, as defined by grants with via.
+// An empty result means the route is not restricted for the machine.
+func (a ACLPolicy) ViaTags(m *Machine, route netip.Prefix) []string {
+	var result []string
+
+	for _, grant := range a.Grants {
+		if len(grant.Via) == 0 || !a.isGrantSource(grant.Source, m) || !a.isViaDestination(grant.Destination, route) {
+			continue
+		}
+
+		for _, tag := range grant.Via {
+			if !slices.Contains(result, tag) {
+				result = append(result, tag)
+			}
+		}
+	}
+
+	return result
+}
+
+// IsExitNodeAllowedVia checks if an exit node can be used by a machine when the internet is only reachable via specific exit nodes.
+func (a ACLPolicy) IsExitNodeAllowedVia(m *Machine, exitNode *Machine) bool {
+	via := a.ViaTags(m, netip.MustParsePrefix("0.0.0.0/0"))
+	return len(via) == 0 || hasAnyTag(via, exitNode.Tags)
+}
+
+// SelectViaRoutes selects the router a machine uses for every route restricted by grants with via.
+// The primary router of a route is selected when it has one of the via tags, otherwise the first online router with one of the tags.
+// A route without any router having one of the tags is mapped to 0, meaning no router is selected.
+func (a ACLPolicy) SelectViaRoutes(m *Machine, routers []Machine, primaries map[netip.Prefix]uint64, isOnline func(*Machine) bool) map[netip.Prefix]uint64 {
+	var candidates = make(map[netip.Prefix][]Machine)
+
+	for _, router := range routers {
+		for _, r := range slices.Concat(router.AllowIPs, router.AutoAllowIPs) {
+			if r.Bits() == 0 {
+				continue
+			}
+
+			via := a.ViaTags(m, r)
+			if len(via) == 0 {
+				continue
+			}
+
+			if _, ok := candidates[r]; !ok {
+				candidates[r] = []Machine{}
+			}
+
+			if hasAnyTag(via, router.Tags) && !slices.ContainsFunc(candidates[r], func(x Machine) bool { return x.ID == router.ID }) {
+				candidates[r] = append(candidates[r], router)
+			}
+		}
+	}
+
+	var result = make(map[netip.Prefix]uint64)
+
+	for r, c := range candidates {
+		result[r] = 0
+		if len(c) == 0 {
+			continue
+		}
+
+		slices.SortFunc(c, func(x, y Machine) int { return cmp.Compare(x.ID, y.ID) })
+		result[r] = c[0].ID
+
+		if i := slices.IndexFunc(c, func(x Machine) bool { return x.ID == primaries[r] }); i != -1 {
+			result[r] = c[i].ID
+		} else if i := slices.IndexFunc(c, func(x Machine) bool { return isOnline(&x) }); i != -1 {
+			result[r] = c[i].ID
+		}
+	}
+
+	return result
+}
+
+func (a ACLPolicy) isGrantSource(aliases []string, m *Machine) bool {
+	for _, alias := range aliases {
+		if len(a.translateSourceAliasToMachineIPs(alias, m, nil)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isViaDestination checks if a route is part of the destinations of a grant,
+// routes are matched by the prefixes containing them and exit node routes by autogroup:internet.
+func (a ACLPolicy) isViaDestination(aliases []string, route netip.Prefix) bool {
+	for _, alias := range aliases {
+		if alias == AutoGroupInternet {
+			if route.Bits() == 0 {
+				return true
+			}
+			continue
+		}
+
+		if h, ok := a.Hosts[alias]; ok {
+			alias = h
+		}
+
+		prefix, err := netip.ParsePrefix(alias)
+		if err != nil {
+			addr, err := netip.ParseAddr(alias)
+			if err != nil {
+				continue
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		if prefix.Bits() <= route.Bits() && prefix.Contains(route.Addr()) {
+			return true
+		}
+	}
+	return false
+}
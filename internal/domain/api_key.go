@@ -36,6 +36,9 @@ func CreateApiKey(tailnet *Tailnet, user *User, expiresAt *time.Time) (string, *
 type ApiKeyRepository interface {
 	SaveApiKey(ctx context.Context, key *ApiKey) error
 	LoadApiKey(ctx context.Context, key string) (*ApiKey, error)
+	GetApiKey(ctx context.Context, id uint64) (*ApiKey, error)
+	ListApiKeysByUser(ctx context.Context, userID uint64) ([]ApiKey, error)
+	DeleteApiKey(ctx context.Context, id uint64) (bool, error)
 	DeleteApiKeysByTailnet(ctx context.Context, tailnetID uint64) error
 	DeleteApiKeysByUser(ctx context.Context, userID uint64) error
 }
@@ -93,6 +96,39 @@ func (r *repository) LoadApiKey(ctx context.Context, key string) (*ApiKey, error
 	return &m, nil
 }
 
+func (r *repository) GetApiKey(ctx context.Context, id uint64) (*ApiKey, error) {
+	var m ApiKey
+	tx := r.withContext(ctx).Preload("User").Preload("Tailnet").Take(&m, "id = ?", id)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &m, nil
+}
+
+func (r *repository) ListApiKeysByUser(ctx context.Context, userID uint64) ([]ApiKey, error) {
+	var apiKeys = []ApiKey{}
+	tx := (r.withContext(ctx).
+		Preload("User").
+		Preload("Tailnet")).
+		Where("user_id = ?", userID).
+		Find(&apiKeys)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return apiKeys, nil
+}
+
+func (r *repository) DeleteApiKey(ctx context.Context, id uint64) (bool, error) {
+	tx := r.withContext(ctx).Delete(&ApiKey{}, id)
+	return tx.RowsAffected == 1, tx.Error
+}
+
 func (r *repository) DeleteApiKeysByTailnet(ctx context.Context, tailnetID uint64) error {
 	tx := r.withContext(ctx).
 		Where("tailnet_id = ?", tailnetID).
@@ -54,6 +54,7 @@ type AuthKey struct {
 	Ephemeral     bool
 	PreAuthorized bool
 	Tags          Tags
+	Description   string
 
 	CreatedAt time.Time
 	ExpiresAt *time.Time
@@ -2,7 +2,10 @@ package domain
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"gorm.io/gorm"
 	"time"
 )
@@ -19,6 +22,39 @@ type AuthenticationRequest struct {
 	TailnetID *uint64
 	Error     string
 	CreatedAt time.Time
+
+	// set by the web console flow once authenticated, limiting the choices of the account
+	AccountID   *uint64
+	SystemAdmin bool
+	TailnetIDs  TailnetIDs
+}
+
+type TailnetIDs []uint64
+
+func (t *TailnetIDs) Scan(destination interface{}) error {
+	switch value := destination.(type) {
+	case nil:
+		*t = TailnetIDs{}
+		return nil
+	case []byte:
+		return json.Unmarshal(value, t)
+	case string:
+		if len(value) == 0 {
+			*t = TailnetIDs{}
+			return nil
+		}
+		return json.Unmarshal([]byte(value), t)
+	default:
+		return fmt.Errorf("unexpected data type %T", destination)
+	}
+}
+
+func (t TailnetIDs) Value() (driver.Value, error) {
+	if t == nil {
+		t = TailnetIDs{}
+	}
+	bytes, err := json.Marshal(t)
+	return string(bytes), err
 }
 
 func (r *repository) SaveAuthenticationRequest(ctx context.Context, session *AuthenticationRequest) error {
@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/tailscale/hujson"
@@ -45,6 +47,12 @@ func (h *HuJSON[T]) String() string {
 	return h.v
 }
 
+// Hash returns the sha256 hash of the document, used to detect concurrent modifications
+func (h *HuJSON[T]) Hash() string {
+	sum := sha256.Sum256([]byte(h.v))
+	return hex.EncodeToString(sum[:])
+}
+
 func (i *HuJSON[T]) Equal(x *HuJSON[T]) bool {
 	if i == nil && x == nil {
 		return true
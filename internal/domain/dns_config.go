@@ -6,16 +6,64 @@ import (
 	"fmt"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
+	"net/netip"
 	"reflect"
+	"strings"
+	"tailscale.com/util/dnsname"
 )
 
 type DNSConfig struct {
+	Domain            string              `json:"domain,omitempty"`
 	HttpsCertsEnabled bool                `json:"http_certs"`
 	MagicDNS          bool                `json:"magic_dns"`
 	OverrideLocalDNS  bool                `json:"override_local_dns"`
 	Nameservers       []string            `json:"nameservers"`
 	Routes            map[string][]string `json:"routes"`
 	SearchDomains     []string            `json:"search_domains"`
+	ExtraRecords      []DNSRecord         `json:"extra_records"`
+}
+
+// IsSubdomain checks if name is equal to, or a subdomain of, the given zone
+func IsSubdomain(name, zone string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return zone != "" && (name == zone || strings.HasSuffix(name, "."+zone))
+}
+
+// DNSRecord is a custom record served by the resolver of the Tailscale clients.
+// When Type is empty, the record is an A or AAAA record depending on the value.
+type DNSRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// Validate checks if the record has a valid name and an IP address matching its type
+func (r DNSRecord) Validate() error {
+	if fqdn, err := dnsname.ToFQDN(r.Name); err != nil || fqdn.WithoutTrailingDot() == "" {
+		return fmt.Errorf("invalid name '%s' for dns record", r.Name)
+	}
+
+	ip, err := netip.ParseAddr(r.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value '%s' for dns record '%s', must be an IP address", r.Value, r.Name)
+	}
+
+	switch r.Type {
+	case "":
+	case "A":
+		if !ip.Is4() {
+			return fmt.Errorf("invalid value '%s' for A record '%s', must be an IPv4 address", r.Value, r.Name)
+		}
+	case "AAAA":
+		if !ip.Is6() {
+			return fmt.Errorf("invalid value '%s' for AAAA record '%s', must be an IPv6 address", r.Value, r.Name)
+		}
+	default:
+		return fmt.Errorf("unsupported type '%s' for dns record '%s', only A and AAAA records are supported", r.Type, r.Name)
+	}
+
+	return nil
 }
 
 func (i *DNSConfig) Equal(x *DNSConfig) bool {
@@ -26,12 +74,14 @@ func (i *DNSConfig) Equal(x *DNSConfig) bool {
 		return false
 	}
 
-	return i.MagicDNS == x.MagicDNS &&
+	return i.Domain == x.Domain &&
+		i.MagicDNS == x.MagicDNS &&
 		i.HttpsCertsEnabled == x.HttpsCertsEnabled &&
 		i.OverrideLocalDNS == x.OverrideLocalDNS &&
 		reflect.DeepEqual(i.Nameservers, x.Nameservers) &&
 		reflect.DeepEqual(i.Routes, x.Routes) &&
-		reflect.DeepEqual(i.SearchDomains, x.SearchDomains)
+		reflect.DeepEqual(i.SearchDomains, x.SearchDomains) &&
+		reflect.DeepEqual(i.ExtraRecords, x.ExtraRecords)
 }
 
 func (i *DNSConfig) Scan(destination interface{}) error {
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"slices"
+	"strings"
+)
+
+type GroupRepository interface {
+	SaveGroup(ctx context.Context, group *Group) error
+	GetGroup(ctx context.Context, id uint64) (*Group, error)
+	ListGroups(ctx context.Context, tailnetID uint64) (Groups, error)
+	DeleteGroup(ctx context.Context, id uint64) error
+	DeleteGroupsByTailnet(ctx context.Context, tailnetID uint64) error
+	ListGroupAliases(ctx context.Context, tailnetID uint64) (map[string][]string, error)
+	GetACLPolicy(ctx context.Context, tailnet *Tailnet) (*ACLPolicy, error)
+}
+
+type Group struct {
+	ID         uint64 `gorm:"primary_key"`
+	Name       string
+	ExternalID string
+	Members    GroupMembers
+
+	TailnetID uint64
+	Tailnet   Tailnet
+}
+
+type Groups []Group
+
+// Alias returns the name used to reference this group in an ACL policy, e.g. group:engineering
+func (g *Group) Alias() string {
+	return GroupAlias(g.Name)
+}
+
+func GroupAlias(name string) string {
+	return fmt.Sprintf("group:%s", strings.ReplaceAll(strings.ToLower(name), " ", "-"))
+}
+
+type GroupMembers []uint64
+
+func (g *GroupMembers) Add(userIDs ...uint64) {
+	for _, id := range userIDs {
+		if !slices.Contains(*g, id) {
+			*g = append(*g, id)
+		}
+	}
+}
+
+func (g *GroupMembers) Remove(userIDs ...uint64) {
+	*g = slices.DeleteFunc(*g, func(id uint64) bool { return slices.Contains(userIDs, id) })
+}
+
+func (g *GroupMembers) Scan(destination interface{}) error {
+	switch value := destination.(type) {
+	case []byte:
+		return json.Unmarshal(value, g)
+	case string:
+		return json.Unmarshal([]byte(value), g)
+	default:
+		return fmt.Errorf("unexpected data type %T", destination)
+	}
+}
+
+func (g GroupMembers) Value() (driver.Value, error) {
+	if g == nil {
+		g = GroupMembers{}
+	}
+	bytes, err := json.Marshal(g)
+	return bytes, err
+}
+
+// GormDataType gorm common data type
+func (GroupMembers) GormDataType() string {
+	return "json"
+}
+
+// GormDBDataType gorm db data type
+func (GroupMembers) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "sqlite":
+		return "JSON"
+	}
+	return ""
+}
+
+// MergeGroups adds the given groups, e.g. provisioned by an identity provider, to the groups defined in the policy
+func (a *ACLPolicy) MergeGroups(groups map[string][]string) {
+	if len(groups) == 0 {
+		return
+	}
+
+	merged := make(map[string][]string)
+	for alias, members := range a.Groups {
+		merged[alias] = members
+	}
+
+	for alias, members := range groups {
+		s := &StringSet{}
+		merged[alias] = s.Add(merged[alias]...).Add(members...).Items()
+	}
+
+	a.Groups = merged
+}
+
+func (r *repository) SaveGroup(ctx context.Context, group *Group) error {
+	tx := r.withContext(ctx).Save(group)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetGroup(ctx context.Context, id uint64) (*Group, error) {
+	var g Group
+	tx := r.withContext(ctx).Take(&g, "id = ?", id)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &g, nil
+}
+
+func (r *repository) ListGroups(ctx context.Context, tailnetID uint64) (Groups, error) {
+	var groups = []Group{}
+
+	tx := r.withContext(ctx).Where("tailnet_id = ?", tailnetID).Order("name asc").Find(&groups)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return groups, nil
+}
+
+func (r *repository) DeleteGroup(ctx context.Context, id uint64) error {
+	tx := r.withContext(ctx).Delete(&Group{ID: id})
+	return tx.Error
+}
+
+func (r *repository) DeleteGroupsByTailnet(ctx context.Context, tailnetID uint64) error {
+	tx := r.withContext(ctx).Where("tailnet_id = ?", tailnetID).Delete(&Group{})
+	return tx.Error
+}
+
+func (r *repository) ListGroupAliases(ctx context.Context, tailnetID uint64) (map[string][]string, error) {
+	groups, err := r.ListGroups(ctx, tailnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	users, err := r.ListUsers(ctx, tailnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint64]string)
+	for _, u := range users {
+		if !u.Suspended {
+			names[u.ID] = u.Name
+		}
+	}
+
+	result := make(map[string][]string)
+	for _, g := range groups {
+		members := []string{}
+		for _, id := range g.Members {
+			if name, ok := names[id]; ok {
+				members = append(members, name)
+			}
+		}
+		result[g.Alias()] = members
+	}
+
+	return result, nil
+}
+
+// GetACLPolicy returns the ACL policy of the tailnet with the provisioned groups merged,
+// so those groups can be used everywhere in the policy, e.g. in tagOwners, autoApprovers and nodeAttrs
+func (r *repository) GetACLPolicy(ctx context.Context, tailnet *Tailnet) (*ACLPolicy, error) {
+	groups, err := r.ListGroupAliases(ctx, tailnet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy the policy, so the policy of the tailnet itself is left untouched
+	policy := *tailnet.ACLPolicy.Get()
+	policy.MergeGroups(groups)
+
+	return &policy, nil
+}
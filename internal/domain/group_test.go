@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGroupAlias(t *testing.T) {
+	assert.Equal(t, "group:engineering", GroupAlias("engineering"))
+	assert.Equal(t, "group:site-reliability", GroupAlias("Site Reliability"))
+}
+
+func TestACLPolicy_MergeGroups(t *testing.T) {
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			Groups: map[string][]string{
+				"group:admins":      {"john@example.com"},
+				"group:engineering": {"jane@example.com"},
+			},
+		},
+	}
+
+	policy.MergeGroups(map[string][]string{
+		"group:engineering": {"joe@example.com", "jane@example.com"},
+		"group:sales":       {"nick@example.com"},
+	})
+
+	assert.Equal(t, map[string][]string{
+		"group:admins":      {"john@example.com"},
+		"group:engineering": {"jane@example.com", "joe@example.com"},
+		"group:sales":       {"nick@example.com"},
+	}, policy.Groups)
+}
+
+func TestACLPolicy_MergeGroupsIsValidPeer(t *testing.T) {
+	src := createMachine("john@example.com")
+	dst := createMachine("jane@example.com")
+
+	policy := ACLPolicy{
+		ionscale.ACLPolicy{
+			ACLs: []ionscale.ACLEntry{
+				{
+					Action:      "accept",
+					Source:      []string{"group:engineering"},
+					Destination: []string{"*:*"},
+				},
+			},
+		},
+	}
+
+	assert.False(t, policy.IsValidPeer(src, dst))
+
+	policy.MergeGroups(map[string][]string{"group:engineering": {"john@example.com"}})
+
+	assert.True(t, policy.IsValidPeer(src, dst))
+}
@@ -12,7 +12,11 @@ import (
 	"reflect"
 )
 
+// DefaultAuthProvider is the name of the auth provider configured with 'provider' in the configuration
+const DefaultAuthProvider = "default"
+
 type Identity struct {
+	Provider string
 	UserID   string
 	Username string
 	Email    string
@@ -30,12 +34,15 @@ func (i *IAMPolicy) GetRole(user User) UserRole {
 	if val, ok := i.Roles[user.Name]; ok {
 		return val
 	}
+	if user.InviteRole != UserRoleNone {
+		return user.InviteRole
+	}
 	return UserRoleMember
 }
 
 func (i *IAMPolicy) EvaluatePolicy(identity *Identity) (bool, error) {
 	for _, sub := range i.Subs {
-		if identity.UserID == sub {
+		if identity.matchesSub(sub) {
 			return true, nil
 		}
 	}
@@ -69,6 +76,15 @@ func (i *IAMPolicy) EvaluatePolicy(identity *Identity) (bool, error) {
 	return false, nil
 }
 
+// matchesSub checks if a sub of a policy refers to this identity. Subjects are only unique within an auth provider,
+// so subs are scoped by provider, e.g. 'github:12345'. Subs without a provider only match identities of the default provider.
+func (i *Identity) matchesSub(sub string) bool {
+	if i.Provider != "" && sub == i.Provider+":"+i.UserID {
+		return true
+	}
+	return (i.Provider == "" || i.Provider == DefaultAuthProvider) && sub == i.UserID
+}
+
 func (i *IAMPolicy) Equal(x *IAMPolicy) bool {
 	if i == nil && x == nil {
 		return true
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIAMPolicy_EvaluatePolicy_Subs(t *testing.T) {
+	policy := IAMPolicy{Subs: []string{"12345", "github:67890"}}
+
+	evaluate := func(provider, sub string) bool {
+		result, err := policy.EvaluatePolicy(&Identity{Provider: provider, UserID: sub})
+		assert.NoError(t, err)
+		return result
+	}
+
+	// subs without a provider only match subjects of the default provider
+	assert.True(t, evaluate(DefaultAuthProvider, "12345"))
+	assert.False(t, evaluate("github", "12345"))
+	assert.False(t, evaluate("gitlab", "12345"))
+
+	// subs scoped to a provider only match subjects of that provider
+	assert.True(t, evaluate("github", "67890"))
+	assert.False(t, evaluate("gitlab", "67890"))
+	assert.False(t, evaluate(DefaultAuthProvider, "67890"))
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/util"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+func CreateInvite(tailnet *Tailnet, email string, role UserRole, maxUses int, expiresAt *time.Time, createdBy string) (string, *Invite) {
+	key := util.RandStringBytes(12)
+	pwd := util.RandStringBytes(22)
+	value := fmt.Sprintf("%s_%s", key, pwd)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+
+	return value, &Invite{
+		ID:        util.NextID(),
+		Key:       key,
+		Hash:      string(hash),
+		Email:     email,
+		Role:      role,
+		MaxUses:   maxUses,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+
+		TailnetID: tailnet.ID,
+	}
+}
+
+type InviteRepository interface {
+	GetInvite(ctx context.Context, id uint64) (*Invite, error)
+	SaveInvite(ctx context.Context, invite *Invite) error
+	LoadInvite(ctx context.Context, value string) (*Invite, error)
+	ClaimInvite(ctx context.Context, id uint64) (bool, error)
+	ListInvites(ctx context.Context, tailnetID uint64) ([]Invite, error)
+	DeleteInvite(ctx context.Context, id uint64) (bool, error)
+	DeleteInvitesByTailnet(ctx context.Context, tailnetID uint64) error
+}
+
+// Invite admits users into a tailnet, regardless of the IAM policy of the tailnet.
+// An invite can be restricted to a single email address, and expires after a given time or number of uses.
+type Invite struct {
+	ID   uint64 `gorm:"primary_key"`
+	Key  string
+	Hash string
+
+	Email     string
+	Role      UserRole
+	MaxUses   int
+	Uses      int
+	CreatedBy string
+
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+
+	TailnetID uint64
+	Tailnet   Tailnet
+}
+
+// IsUsable checks if the invite is not expired and not used up
+func (i *Invite) IsUsable(now time.Time) bool {
+	if i.ExpiresAt != nil && !i.ExpiresAt.IsZero() && i.ExpiresAt.Before(now) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
+
+// Allows checks if the invite can be accepted by a user with the given login name
+func (i *Invite) Allows(loginName string) bool {
+	return i.Email == "" || strings.EqualFold(i.Email, loginName)
+}
+
+func (r *repository) GetInvite(ctx context.Context, id uint64) (*Invite, error) {
+	var m Invite
+	tx := r.withContext(ctx).Preload("Tailnet").Take(&m, "id = ?", id)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &m, nil
+}
+
+func (r *repository) SaveInvite(ctx context.Context, invite *Invite) error {
+	tx := r.withContext(ctx).Save(invite)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+// LoadInvite looks up an invite by the value of the invite link, without checking if the invite is still usable
+func (r *repository) LoadInvite(ctx context.Context, value string) (*Invite, error) {
+	split := strings.Split(value, "_")
+	if len(split) != 2 {
+		return nil, nil
+	}
+
+	var m Invite
+	tx := r.withContext(ctx).Preload("Tailnet").Take(&m, "key = ?", split[0])
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(m.Hash), []byte(split[1])); err != nil {
+		return nil, nil
+	}
+
+	return &m, nil
+}
+
+// ClaimInvite registers a use of an invite, it returns false when the invite was used up in the meantime
+func (r *repository) ClaimInvite(ctx context.Context, id uint64) (bool, error) {
+	tx := r.withContext(ctx).
+		Model(&Invite{}).
+		Where("id = ? AND (max_uses = 0 OR uses < max_uses)", id).
+		Update("uses", gorm.Expr("uses + 1"))
+
+	return tx.RowsAffected == 1, tx.Error
+}
+
+func (r *repository) ListInvites(ctx context.Context, tailnetID uint64) ([]Invite, error) {
+	var invites = []Invite{}
+	tx := r.withContext(ctx).
+		Preload("Tailnet").
+		Where("tailnet_id = ?", tailnetID).
+		Order("created_at asc").
+		Find(&invites)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return invites, nil
+}
+
+func (r *repository) DeleteInvite(ctx context.Context, id uint64) (bool, error) {
+	tx := r.withContext(ctx).Delete(&Invite{}, id)
+	return tx.RowsAffected == 1, tx.Error
+}
+
+func (r *repository) DeleteInvitesByTailnet(ctx context.Context, tailnetID uint64) error {
+	tx := r.withContext(ctx).
+		Where("tailnet_id = ?", tailnetID).
+		Delete(&Invite{})
+
+	return tx.Error
+}
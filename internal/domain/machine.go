@@ -19,6 +19,7 @@ type MachineRepository interface {
 	GetMachine(ctx context.Context, id uint64) (*Machine, error)
 	GetMachineByKeyAndUser(ctx context.Context, key string, userID uint64) (*Machine, error)
 	GetMachineByKeys(ctx context.Context, machineKey string, nodeKey string) (*Machine, error)
+	GetMachineByNodeKey(ctx context.Context, nodeKey string) (*Machine, error)
 	CountMachinesWithIPv4(ctx context.Context, ip string) (int64, error)
 	GetNextMachineNameIndex(ctx context.Context, tailnetID uint64, name string) (uint64, error)
 	ListMachineByTailnet(ctx context.Context, tailnetID uint64) (Machines, error)
@@ -44,6 +45,10 @@ type Machine struct {
 	Authorized        bool
 	UseOSHostname     bool `gorm:"default:true"`
 
+	// Quarantined machines keep their registration, but are isolated from all peers
+	Quarantined      bool
+	QuarantineReason string
+
 	HostInfo     HostInfo
 	Endpoints    Endpoints
 	AllowIPs     AllowIPs
@@ -61,6 +66,10 @@ type Machine struct {
 
 	TailnetID uint64
 	Tailnet   Tailnet
+
+	// Sharee is set for machines of a tailnet a machine is shared with,
+	// in the policy of the sharing tailnet those machines only match autogroup:shared
+	Sharee bool `gorm:"-"`
 }
 
 type Machines []Machine
@@ -72,6 +81,12 @@ func (m *Machine) CompleteName() string {
 	return m.Name
 }
 
+// IsIsolated checks if a machine is cut off from all peers, because it is quarantined or its owner is suspended.
+// Tagged machines are not owned by a user, so those are not affected by a suspension.
+func (m *Machine) IsIsolated() bool {
+	return m.Quarantined || (!m.HasTags() && m.User.Suspended)
+}
+
 func (m *Machine) IPs() []string {
 	return []string{m.IPv4.String(), m.IPv6.String()}
 }
@@ -368,9 +383,19 @@ func (r *repository) SaveMachine(ctx context.Context, machine *Machine) error {
 	return nil
 }
 
+// DeleteMachine deletes the machine together with the shares of the machine
 func (r *repository) DeleteMachine(ctx context.Context, id uint64) (bool, error) {
-	tx := r.withContext(ctx).Delete(&Machine{}, id)
-	return tx.RowsAffected == 1, tx.Error
+	var deleted bool
+	err := r.withContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("machine_id = ?", id).Delete(&MachineShare{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&Machine{}, id)
+		deleted = result.RowsAffected == 1
+		return result.Error
+	})
+	return deleted, err
 }
 
 func (r *repository) GetMachine(ctx context.Context, machineID uint64) (*Machine, error) {
@@ -437,6 +462,21 @@ func (r *repository) GetMachineByKeys(ctx context.Context, machineKey string, no
 	return &m, nil
 }
 
+func (r *repository) GetMachineByNodeKey(ctx context.Context, nodeKey string) (*Machine, error) {
+	var m Machine
+	tx := r.withContext(ctx).Take(&m, "node_key = ?", nodeKey)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &m, nil
+}
+
 func (r *repository) CountMachinesWithIPv4(ctx context.Context, ip string) (int64, error) {
 	var count int64
 
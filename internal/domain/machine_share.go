@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+type MachineShareRepository interface {
+	SaveMachineShare(ctx context.Context, share *MachineShare) error
+	GetMachineShare(ctx context.Context, id uint64) (*MachineShare, error)
+	DeleteMachineShare(ctx context.Context, id uint64) error
+	DeleteMachineSharesByTailnet(ctx context.Context, tailnetID uint64) error
+	ListMachineSharesByTailnet(ctx context.Context, tailnetID uint64) ([]MachineShare, error)
+	ListSharedMachines(ctx context.Context, tailnetID uint64) (Machines, error)
+	ListMachineSharees(ctx context.Context, machineID uint64) (Machines, error)
+	ListSharingTailnetIDs(ctx context.Context, tailnetID uint64) ([]uint64, error)
+}
+
+// MachineShare shares a machine with another tailnet. The share is pending until an admin of the
+// receiving tailnet accepts the invite, after which the machine is visible as a peer in that tailnet.
+type MachineShare struct {
+	ID       uint64 `gorm:"primary_key"`
+	Accepted bool
+
+	CreatedAt time.Time
+
+	MachineID uint64
+	Machine   Machine
+
+	SourceTailnetID uint64
+	SourceTailnet   Tailnet
+
+	TailnetID uint64
+	Tailnet   Tailnet
+}
+
+func (r *repository) SaveMachineShare(ctx context.Context, share *MachineShare) error {
+	tx := r.withContext(ctx).Save(share)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetMachineShare(ctx context.Context, id uint64) (*MachineShare, error) {
+	var m MachineShare
+	tx := r.withContext(ctx).
+		Preload("Machine").
+		Preload("SourceTailnet").
+		Preload("Tailnet").
+		Take(&m, "id = ?", id)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &m, nil
+}
+
+func (r *repository) DeleteMachineShare(ctx context.Context, id uint64) error {
+	tx := r.withContext(ctx).Delete(&MachineShare{ID: id})
+	return tx.Error
+}
+
+func (r *repository) DeleteMachineSharesByTailnet(ctx context.Context, tailnetID uint64) error {
+	tx := r.withContext(ctx).
+		Where("source_tailnet_id = ? OR tailnet_id = ?", tailnetID, tailnetID).
+		Delete(&MachineShare{})
+
+	return tx.Error
+}
+
+// ListMachineSharesByTailnet lists the shares of machines from and to the given tailnet
+func (r *repository) ListMachineSharesByTailnet(ctx context.Context, tailnetID uint64) ([]MachineShare, error) {
+	var shares = []MachineShare{}
+
+	tx := r.withContext(ctx).
+		InnerJoins("Machine").
+		Preload("SourceTailnet").
+		Preload("Tailnet").
+		Where("machine_shares.source_tailnet_id = ? OR machine_shares.tailnet_id = ?", tailnetID, tailnetID).
+		Order("machine_shares.id asc").
+		Find(&shares)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return shares, nil
+}
+
+// ListSharedMachines lists the machines of other tailnets shared with the given tailnet
+func (r *repository) ListSharedMachines(ctx context.Context, tailnetID uint64) (Machines, error) {
+	var machines []Machine
+
+	tx := r.withContext(ctx).
+		Preload("Tailnet").
+		Joins("User").
+		Joins("User.Account").
+		Where("machines.id IN (?)", r.withContext(ctx).
+			Model(&MachineShare{}).
+			Select("machine_id").
+			Where("tailnet_id = ? AND accepted = ?", tailnetID, true)).
+		Order("machines.id asc").
+		Find(&machines)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return machines, nil
+}
+
+// ListMachineSharees lists the machines of all tailnets the given machine is shared with
+func (r *repository) ListMachineSharees(ctx context.Context, machineID uint64) (Machines, error) {
+	var machines []Machine
+
+	tx := r.withContext(ctx).
+		Preload("Tailnet").
+		Joins("User").
+		Joins("User.Account").
+		Where("machines.tailnet_id IN (?)", r.withContext(ctx).
+			Model(&MachineShare{}).
+			Select("tailnet_id").
+			Where("machine_id = ? AND accepted = ?", machineID, true)).
+		Order("machines.id asc").
+		Find(&machines)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	for i := range machines {
+		machines[i].Sharee = true
+	}
+
+	return machines, nil
+}
+
+// ListSharingTailnetIDs lists the tailnets with an accepted share from or to the given tailnet
+func (r *repository) ListSharingTailnetIDs(ctx context.Context, tailnetID uint64) ([]uint64, error) {
+	var shares []MachineShare
+
+	tx := r.withContext(ctx).
+		Where("accepted = ? AND (source_tailnet_id = ? OR tailnet_id = ?)", true, tailnetID, tailnetID).
+		Find(&shares)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	var ids []uint64
+	for _, s := range shares {
+		if s.SourceTailnetID != tailnetID {
+			ids = append(ids, s.SourceTailnetID)
+		}
+		if s.TailnetID != tailnetID {
+			ids = append(ids, s.TailnetID)
+		}
+	}
+
+	return ids, nil
+}
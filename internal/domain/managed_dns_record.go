@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ManagedDNSRecordRepository interface {
+	SetManagedDNSRecord(ctx context.Context, record *ManagedDNSRecord) error
+	ListExpiredManagedDNSRecords(ctx context.Context, checkpoint time.Time) ([]ManagedDNSRecord, error)
+	DeleteManagedDNSRecord(ctx context.Context, id uint64) error
+}
+
+// ManagedDNSRecord tracks a record written by ionscale to the zone of the DNS provider on behalf of a machine,
+// typically the TXT record of an ACME DNS-01 challenge, so it can be removed again when no longer needed.
+type ManagedDNSRecord struct {
+	ID        uint64 `gorm:"primary_key"`
+	Type      string
+	Name      string
+	Value     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	MachineID uint64
+}
+
+// SetManagedDNSRecord saves the record, replacing the records with the same name and type,
+// like setting a record replaces the existing values in the zone of the DNS provider.
+func (r *repository) SetManagedDNSRecord(ctx context.Context, record *ManagedDNSRecord) error {
+	return r.withContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("name = ? AND type = ?", record.Name, record.Type).Delete(&ManagedDNSRecord{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(record).Error
+	})
+}
+
+// ListExpiredManagedDNSRecords lists the records which expired before the checkpoint, or of which the machine was deleted
+func (r *repository) ListExpiredManagedDNSRecords(ctx context.Context, checkpoint time.Time) ([]ManagedDNSRecord, error) {
+	var records = []ManagedDNSRecord{}
+
+	tx := r.withContext(ctx).
+		Where("expires_at <= ? OR machine_id NOT IN (?)", checkpoint, r.withContext(ctx).Model(&Machine{}).Select("id")).
+		Find(&records)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return records, nil
+}
+
+func (r *repository) DeleteManagedDNSRecord(ctx context.Context, id uint64) error {
+	tx := r.withContext(ctx).Delete(&ManagedDNSRecord{}, id)
+	return tx.Error
+}
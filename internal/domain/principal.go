@@ -14,6 +14,18 @@ func (p Principal) IsTailnetAdmin(tailnetID uint64) bool {
 	return p.User.TailnetID == tailnetID && p.UserRole.IsAdmin()
 }
 
+func (p Principal) IsTailnetAuditor(tailnetID uint64) bool {
+	return p.User.TailnetID == tailnetID && p.UserRole.IsAuditor()
+}
+
+func (p Principal) IsTailnetNetworkAdmin(tailnetID uint64) bool {
+	return p.User.TailnetID == tailnetID && p.UserRole.IsNetworkAdmin()
+}
+
+func (p Principal) IsTailnetITAdmin(tailnetID uint64) bool {
+	return p.User.TailnetID == tailnetID && p.UserRole.IsITAdmin()
+}
+
 func (p Principal) IsTailnetMember(tailnetID uint64) bool {
 	return p.User.TailnetID == tailnetID
 }
@@ -21,3 +33,9 @@ func (p Principal) IsTailnetMember(tailnetID uint64) bool {
 func (p Principal) UserMatches(userID uint64) bool {
 	return p.User.ID == userID
 }
+
+// IsMachineOwner checks if a machine is registered by the user of the principal,
+// tagged machines are owned by their tags instead of the user who registered them.
+func (p Principal) IsMachineOwner(m *Machine) bool {
+	return p.User != nil && p.UserMatches(m.UserID) && !m.HasTags()
+}
@@ -17,6 +17,12 @@ type Repository interface {
 	AuthenticationRequestRepository
 	RegistrationRequestRepository
 	SSHActionRequestRepository
+	GroupRepository
+	SCIMTokenRepository
+	MachineShareRepository
+	TemporaryGrantRepository
+	InviteRepository
+	ManagedDNSRecordRepository
 
 	GetControlKeys(ctx context.Context) (*ControlKeys, error)
 	SetControlKeys(ctx context.Context, keys *ControlKeys) error
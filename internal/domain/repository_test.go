@@ -0,0 +1,201 @@
+package domain_test
+
+import (
+	"context"
+	"net/netip"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"tailscale.com/tailcfg"
+)
+
+func newTestRepository(t *testing.T) domain.Repository {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+	return repository
+}
+
+func TestRepository_GetACLPolicy(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := &domain.Tailnet{
+		ID:   util.NextID(),
+		Name: "tailnet",
+		ACLPolicy: domain.NewHuJSON(&domain.ACLPolicy{ACLPolicy: ionscale.ACLPolicy{
+			TagOwners: map[string][]string{"tag:web": {"group:engineering"}},
+			AutoApprovers: &ionscale.ACLAutoApprovers{
+				Routes: map[string][]string{"10.0.0.0/8": {"group:engineering"}},
+			},
+			NodeAttrs: []ionscale.ACLNodeAttrGrant{
+				{Target: []string{"group:engineering"}, Attr: []string{"mullvad"}},
+			},
+		}}),
+	}
+	require.NoError(t, repository.SaveTailnet(ctx, tailnet))
+
+	john := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(ctx, john))
+
+	machine := &domain.Machine{User: *john}
+
+	policy, err := repository.GetACLPolicy(ctx, tailnet)
+	require.NoError(t, err)
+	require.Error(t, policy.CheckTagOwners([]string{"tag:web"}, john))
+	require.Empty(t, policy.FindAutoApprovedIPs([]netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}, nil, john))
+	require.Empty(t, policy.NodeCapMap(machine))
+
+	group := &domain.Group{ID: util.NextID(), Name: "Engineering", Members: domain.GroupMembers{john.ID}, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveGroup(ctx, group))
+
+	policy, err = repository.GetACLPolicy(ctx, tailnet)
+	require.NoError(t, err)
+	require.NoError(t, policy.CheckTagOwners([]string{"tag:web"}, john))
+	require.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}, policy.FindAutoApprovedIPs([]netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}, nil, john))
+	require.Contains(t, policy.NodeCapMap(machine), tailcfg.NodeCapability("mullvad"))
+
+	// the stored policy itself is left untouched
+	require.NotContains(t, tailnet.ACLPolicy.Get().Groups, "group:engineering")
+}
+
+func TestRepository_DeleteMachine_DeletesShares(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	source := &domain.Tailnet{ID: util.NextID(), Name: "source"}
+	require.NoError(t, repository.SaveTailnet(ctx, source))
+
+	receiver := &domain.Tailnet{ID: util.NextID(), Name: "receiver"}
+	require.NoError(t, repository.SaveTailnet(ctx, receiver))
+
+	john := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: source.ID}
+	require.NoError(t, repository.SaveUser(ctx, john))
+
+	machine := &domain.Machine{ID: util.NextID(), Name: "web", TailnetID: source.ID, UserID: john.ID}
+	require.NoError(t, repository.SaveMachine(ctx, machine))
+
+	share := &domain.MachineShare{ID: util.NextID(), Accepted: true, MachineID: machine.ID, SourceTailnetID: source.ID, TailnetID: receiver.ID}
+	require.NoError(t, repository.SaveMachineShare(ctx, share))
+
+	shared, err := repository.ListSharedMachines(ctx, receiver.ID)
+	require.NoError(t, err)
+	require.Len(t, shared, 1)
+
+	deleted, err := repository.DeleteMachine(ctx, machine.ID)
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	s, err := repository.GetMachineShare(ctx, share.ID)
+	require.NoError(t, err)
+	require.Nil(t, s)
+
+	shares, err := repository.ListMachineSharesByTailnet(ctx, receiver.ID)
+	require.NoError(t, err)
+	require.Empty(t, shares)
+
+	ids, err := repository.ListSharingTailnetIDs(ctx, receiver.ID)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestRepository_GetOrCreateUserWithAccount_LinksProvisionedUser(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := &domain.Tailnet{ID: util.NextID(), Name: "tailnet"}
+	require.NoError(t, repository.SaveTailnet(ctx, tailnet))
+
+	provisioned := &domain.User{ID: util.NextID(), Name: "John@Example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID, ExternalID: "scim-1"}
+	require.NoError(t, repository.SaveUser(ctx, provisioned))
+
+	// an account of another provider with the same login name can't claim the provisioned user
+	github, _, err := repository.GetOrCreateAccount(ctx, "github", "1234", "john@example.com")
+	require.NoError(t, err)
+
+	user, created, err := repository.GetOrCreateUserWithAccount(ctx, tailnet, github)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.NotEqual(t, provisioned.ID, user.ID)
+
+	// the account of the default provider is linked, ignoring the case of the name
+	account, _, err := repository.GetOrCreateAccount(ctx, domain.DefaultAuthProvider, "john", "john@example.com")
+	require.NoError(t, err)
+
+	user, created, err = repository.GetOrCreateUserWithAccount(ctx, tailnet, account)
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, provisioned.ID, user.ID)
+	require.Equal(t, "scim-1", user.ExternalID)
+
+	// when the tailnet restricts its providers, only those link provisioned users
+	restricted := &domain.Tailnet{ID: util.NextID(), Name: "restricted", AuthProviders: domain.AuthProviders{"github"}}
+	require.NoError(t, repository.SaveTailnet(ctx, restricted))
+
+	other := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: restricted.ID}
+	require.NoError(t, repository.SaveUser(ctx, other))
+
+	user, _, err = repository.GetOrCreateUserWithAccount(ctx, restricted, github)
+	require.NoError(t, err)
+	require.Equal(t, other.ID, user.ID)
+}
+
+func TestRepository_ListExpiredManagedDNSRecords(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	tailnet := &domain.Tailnet{ID: util.NextID(), Name: "tailnet"}
+	require.NoError(t, repository.SaveTailnet(ctx, tailnet))
+
+	john := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(ctx, john))
+
+	laptop := &domain.Machine{ID: util.NextID(), Name: "laptop", TailnetID: tailnet.ID, UserID: john.ID}
+	require.NoError(t, repository.SaveMachine(ctx, laptop))
+
+	server := &domain.Machine{ID: util.NextID(), Name: "server", TailnetID: tailnet.ID, UserID: john.ID}
+	require.NoError(t, repository.SaveMachine(ctx, server))
+
+	setRecord := func(m *domain.Machine, name, value string, expiresAt time.Time) {
+		require.NoError(t, repository.SetManagedDNSRecord(ctx, &domain.ManagedDNSRecord{
+			ID:        util.NextID(),
+			Type:      "TXT",
+			Name:      name,
+			Value:     value,
+			CreatedAt: now,
+			ExpiresAt: expiresAt,
+			MachineID: m.ID,
+		}))
+	}
+
+	setRecord(laptop, "_acme-challenge.laptop.tailnet.ionscale.net", "first", now.Add(-time.Minute))
+	setRecord(laptop, "_acme-challenge.laptop.tailnet.ionscale.net", "second", now.Add(10*time.Minute))
+	setRecord(server, "_acme-challenge.server.tailnet.ionscale.net", "value", now.Add(10*time.Minute))
+
+	expired, err := repository.ListExpiredManagedDNSRecords(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	_, err = repository.DeleteMachine(ctx, server.ID)
+	require.NoError(t, err)
+
+	expired, err = repository.ListExpiredManagedDNSRecords(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, "_acme-challenge.server.tailnet.ionscale.net", expired[0].Name)
+
+	require.NoError(t, repository.DeleteManagedDNSRecord(ctx, expired[0].ID))
+
+	expired, err = repository.ListExpiredManagedDNSRecords(ctx, now.Add(11*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, "second", expired[0].Value)
+}
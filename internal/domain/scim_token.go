@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/util"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+func CreateSCIMToken(tailnet *Tailnet) (string, *SCIMToken) {
+	key := util.RandStringBytes(12)
+	pwd := util.RandStringBytes(22)
+	value := fmt.Sprintf("scim_%s_%s", key, pwd)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+
+	return value, &SCIMToken{
+		ID:        util.NextID(),
+		Key:       key,
+		Hash:      string(hash),
+		CreatedAt: time.Now().UTC(),
+
+		TailnetID: tailnet.ID,
+	}
+}
+
+type SCIMTokenRepository interface {
+	SaveSCIMToken(ctx context.Context, token *SCIMToken) error
+	LoadSCIMToken(ctx context.Context, token string) (*SCIMToken, error)
+	DeleteSCIMTokensByTailnet(ctx context.Context, tailnetID uint64) error
+}
+
+type SCIMToken struct {
+	ID   uint64 `gorm:"primary_key"`
+	Key  string
+	Hash string
+
+	CreatedAt time.Time
+
+	TailnetID uint64
+	Tailnet   Tailnet
+}
+
+func (r *repository) SaveSCIMToken(ctx context.Context, token *SCIMToken) error {
+	tx := r.withContext(ctx).Save(token)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (r *repository) LoadSCIMToken(ctx context.Context, token string) (*SCIMToken, error) {
+	split := strings.Split(token, "_")
+	if len(split) != 3 || split[0] != "scim" {
+		return nil, nil
+	}
+
+	var m SCIMToken
+	tx := r.withContext(ctx).Preload("Tailnet").Take(&m, "key = ?", split[1])
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(m.Hash), []byte(split[2])); err != nil {
+		return nil, nil
+	}
+
+	return &m, nil
+}
+
+func (r *repository) DeleteSCIMTokensByTailnet(ctx context.Context, tailnetID uint64) error {
+	tx := r.withContext(ctx).
+		Where("tailnet_id = ?", tailnetID).
+		Delete(&SCIMToken{TailnetID: tailnetID})
+
+	return tx.Error
+}
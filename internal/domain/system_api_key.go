@@ -35,6 +35,7 @@ func CreateSystemApiKey(account *Account, expiresAt *time.Time) (string, *System
 type SystemApiKeyRepository interface {
 	SaveSystemApiKey(ctx context.Context, key *SystemApiKey) error
 	LoadSystemApiKey(ctx context.Context, key string) (*SystemApiKey, error)
+	DeleteSystemApiKey(ctx context.Context, id uint64) (bool, error)
 }
 
 type SystemApiKey struct {
@@ -94,3 +95,8 @@ func (r *repository) LoadSystemApiKey(ctx context.Context, token string) (*Syste
 
 	return &m, nil
 }
+
+func (r *repository) DeleteSystemApiKey(ctx context.Context, id uint64) (bool, error) {
+	tx := r.withContext(ctx).Delete(&SystemApiKey{}, id)
+	return tx.RowsAffected == 1, tx.Error
+}
@@ -2,9 +2,13 @@ package domain
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"gorm.io/gorm"
 	"net/mail"
+	"slices"
 	"strings"
 	"tailscale.com/util/dnsname"
 )
@@ -20,10 +24,63 @@ type Tailnet struct {
 	FileSharingEnabled          bool
 	SSHEnabled                  bool
 	MachineAuthorizationEnabled bool
+	AuthProviders               AuthProviders
+}
+
+// AuthProviders holds the names of the auth providers allowed to join a tailnet, when empty all providers are allowed
+type AuthProviders []string
+
+func SanitizeAuthProviders(input []string) AuthProviders {
+	s := StringSet{}
+	return s.Add(input...).Items()
+}
+
+func (a AuthProviders) Allows(name string) bool {
+	return len(a) == 0 || slices.Contains(a, name)
+}
+
+// LinksProvisioned returns whether accounts of the given provider may claim users provisioned upfront (e.g. via SCIM),
+// which is limited to the allowed providers of the tailnet, or the default provider when all providers are allowed
+func (a AuthProviders) LinksProvisioned(name string) bool {
+	if len(a) == 0 {
+		return name == DefaultAuthProvider
+	}
+	return slices.Contains(a, name)
+}
+
+func (a *AuthProviders) Scan(destination interface{}) error {
+	switch value := destination.(type) {
+	case nil:
+		*a = AuthProviders{}
+		return nil
+	case []byte:
+		return a.unmarshal(string(value))
+	case string:
+		return a.unmarshal(value)
+	default:
+		return fmt.Errorf("unexpected data type %T", destination)
+	}
+}
+
+func (a *AuthProviders) unmarshal(value string) error {
+	if len(value) == 0 {
+		*a = AuthProviders{}
+		return nil
+	}
+	return json.Unmarshal([]byte(value), a)
+}
+
+func (a AuthProviders) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(a)
+	return string(bytes), err
 }
 
 type TailnetRepository interface {
 	SaveTailnet(ctx context.Context, tailnet *Tailnet) error
+	UpdateACLPolicy(ctx context.Context, tailnetID uint64, previous, policy *HuJSON[ACLPolicy]) (bool, error)
 	GetTailnet(ctx context.Context, id uint64) (*Tailnet, error)
 	GetTailnetByName(ctx context.Context, name string) (*Tailnet, error)
 	ListTailnets(ctx context.Context) ([]Tailnet, error)
@@ -55,6 +112,15 @@ func SanitizeTailnetName(name string) string {
 	return strings.Join(labels, ".")
 }
 
+// DNSDomain returns the MagicDNS domain of the tailnet, which is the custom domain when configured,
+// or else a subdomain of the given suffix based on the name of the tailnet.
+func (t Tailnet) DNSDomain(suffix string) string {
+	if t.DNSConfig.Domain != "" {
+		return t.DNSConfig.Domain
+	}
+	return fmt.Sprintf("%s.%s", SanitizeTailnetName(t.Name), suffix)
+}
+
 func (r *repository) SaveTailnet(ctx context.Context, tailnet *Tailnet) error {
 	tx := r.withContext(ctx).Save(tailnet)
 
@@ -65,6 +131,22 @@ func (r *repository) SaveTailnet(ctx context.Context, tailnet *Tailnet) error {
 	return nil
 }
 
+// UpdateACLPolicy replaces the ACL policy of a tailnet, only when the stored policy still equals the previous policy.
+// It returns false when the policy was modified concurrently.
+func (r *repository) UpdateACLPolicy(ctx context.Context, tailnetID uint64, previous, policy *HuJSON[ACLPolicy]) (bool, error) {
+	tx := r.withContext(ctx).Model(&Tailnet{}).Where("id = ?", tailnetID)
+
+	if previous.String() == "" {
+		tx = tx.Where("acl_policy IS NULL OR acl_policy = ''")
+	} else {
+		tx = tx.Where("acl_policy = ?", previous.String())
+	}
+
+	tx = tx.Update("acl_policy", policy)
+
+	return tx.RowsAffected == 1, tx.Error
+}
+
 func (r *repository) GetTailnet(ctx context.Context, id uint64) (*Tailnet, error) {
 	var t Tailnet
 	tx := r.withContext(ctx).Take(&t, "id = ?", id)
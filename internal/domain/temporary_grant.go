@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"gorm.io/gorm"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+type TemporaryGrantState string
+
+const (
+	TemporaryGrantPending  TemporaryGrantState = "pending"
+	TemporaryGrantApproved TemporaryGrantState = "approved"
+	TemporaryGrantRevoked  TemporaryGrantState = "revoked"
+	TemporaryGrantExpired  TemporaryGrantState = "expired"
+)
+
+type TemporaryGrantRepository interface {
+	SaveTemporaryGrant(ctx context.Context, grant *TemporaryGrant) error
+	GetTemporaryGrant(ctx context.Context, id uint64) (*TemporaryGrant, error)
+	ListTemporaryGrants(ctx context.Context, tailnetID uint64) ([]TemporaryGrant, error)
+	ListActiveTemporaryGrants(ctx context.Context, tailnetID uint64) ([]TemporaryGrant, error)
+	ListExpiredTemporaryGrants(ctx context.Context, checkpoint time.Time) ([]TemporaryGrant, error)
+	DeleteTemporaryGrantsByTailnet(ctx context.Context, tailnetID uint64) error
+}
+
+// TemporaryGrant gives a user or group access to a destination for a limited time, on top of the ACL policy of the tailnet.
+// A grant is requested with a reason, becomes active when approved and expires after the requested duration.
+// The principals requesting, approving and revoking the grant are kept as audit trail.
+type TemporaryGrant struct {
+	ID        uint64 `gorm:"primary_key"`
+	TailnetID uint64
+
+	Source      string
+	Destination string
+	Ports       string
+	Duration    time.Duration
+	Reason      string
+	State       TemporaryGrantState
+
+	RequestedBy   string
+	RequestedByID uint64
+	RequestedAt   time.Time
+	ApprovedBy    string
+	ApprovedAt    *time.Time
+	RevokedBy     string
+	RevokedAt     *time.Time
+	ExpiresAt     *time.Time
+}
+
+// Validate checks if the grant can be converted to a valid ACL entry of the given policy
+func (g *TemporaryGrant) Validate(policy *ACLPolicy) error {
+	if !strings.Contains(g.Source, "@") && !strings.HasPrefix(g.Source, "group:") {
+		return fmt.Errorf("invalid source '%s', expected a user or a group", g.Source)
+	}
+
+	if g.Destination == "" {
+		return fmt.Errorf("destination is required")
+	}
+
+	if !policy.isKnownDestination(g.Destination) {
+		return fmt.Errorf("invalid destination '%s', expected a user, group, tag, host, ip or cidr of the policy", g.Destination)
+	}
+
+	if _, err := (ACLPolicy{}).parsePortRanges(g.Ports); err != nil {
+		return fmt.Errorf("invalid ports '%s': %w", g.Ports, err)
+	}
+
+	if g.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+
+	return nil
+}
+
+// isKnownDestination reports whether the alias is a valid destination, groups, tags and hosts have to be defined in the policy
+func (a *ACLPolicy) isKnownDestination(alias string) bool {
+	switch {
+	case alias == "*", alias == AutoGroupMember, alias == AutoGroupMembers, alias == AutoGroupSelf, alias == AutoGroupTagged, alias == AutoGroupInternet:
+		return true
+	case strings.Contains(alias, "@"):
+		return true
+	case strings.HasPrefix(alias, "group:"):
+		_, ok := a.Groups[alias]
+		return ok
+	case strings.HasPrefix(alias, "tag:"):
+		_, ok := a.TagOwners[alias]
+		return ok
+	}
+
+	if _, ok := a.Hosts[alias]; ok {
+		return true
+	}
+
+	if _, err := netip.ParseAddr(alias); err == nil {
+		return true
+	}
+
+	_, err := netip.ParsePrefix(alias)
+	return err == nil
+}
+
+func (g *TemporaryGrant) IsActive(now time.Time) bool {
+	return g.State == TemporaryGrantApproved && g.ExpiresAt != nil && g.ExpiresAt.After(now)
+}
+
+// ToACLEntry converts the grant to the ACL entry merged into the ACL policy while the grant is active
+func (g *TemporaryGrant) ToACLEntry() ionscale.ACLEntry {
+	return ionscale.ACLEntry{
+		Action:      "accept",
+		Source:      []string{g.Source},
+		Destination: []string{fmt.Sprintf("%s:%s", g.Destination, g.Ports)},
+	}
+}
+
+// MergeTemporaryGrants adds the active temporary grants to the ACL policy
+func (a *ACLPolicy) MergeTemporaryGrants(grants []TemporaryGrant) {
+	now := time.Now().UTC()
+	for _, g := range grants {
+		if g.IsActive(now) {
+			a.ACLs = append(a.ACLs, g.ToACLEntry())
+		}
+	}
+}
+
+func (r *repository) SaveTemporaryGrant(ctx context.Context, grant *TemporaryGrant) error {
+	tx := r.withContext(ctx).Save(grant)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetTemporaryGrant(ctx context.Context, id uint64) (*TemporaryGrant, error) {
+	var g TemporaryGrant
+	tx := r.withContext(ctx).Take(&g, "id = ?", id)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &g, nil
+}
+
+func (r *repository) ListTemporaryGrants(ctx context.Context, tailnetID uint64) ([]TemporaryGrant, error) {
+	var grants = []TemporaryGrant{}
+
+	tx := r.withContext(ctx).
+		Where("tailnet_id = ?", tailnetID).
+		Order("id asc").
+		Find(&grants)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return grants, nil
+}
+
+func (r *repository) ListActiveTemporaryGrants(ctx context.Context, tailnetID uint64) ([]TemporaryGrant, error) {
+	var grants = []TemporaryGrant{}
+
+	tx := r.withContext(ctx).
+		Where("tailnet_id = ? AND state = ? AND expires_at > ?", tailnetID, TemporaryGrantApproved, time.Now().UTC()).
+		Order("id asc").
+		Find(&grants)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return grants, nil
+}
+
+// ListExpiredTemporaryGrants lists the approved grants which expired before the checkpoint
+func (r *repository) ListExpiredTemporaryGrants(ctx context.Context, checkpoint time.Time) ([]TemporaryGrant, error) {
+	var grants = []TemporaryGrant{}
+
+	tx := r.withContext(ctx).
+		Where("state = ? AND expires_at <= ?", TemporaryGrantApproved, checkpoint).
+		Find(&grants)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return grants, nil
+}
+
+func (r *repository) DeleteTemporaryGrantsByTailnet(ctx context.Context, tailnetID uint64) error {
+	tx := r.withContext(ctx).
+		Where("tailnet_id = ?", tailnetID).
+		Delete(&TemporaryGrant{})
+
+	return tx.Error
+}
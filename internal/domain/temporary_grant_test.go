@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestACLPolicy_MergeTemporaryGrants(t *testing.T) {
+	now := time.Now().UTC()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	policy := ACLPolicy{}
+	policy.MergeTemporaryGrants([]TemporaryGrant{
+		{Source: "john@example.com", Destination: "tag:prod", Ports: "22", State: TemporaryGrantApproved, ExpiresAt: &future},
+		{Source: "jane@example.com", Destination: "tag:prod", Ports: "*", State: TemporaryGrantApproved, ExpiresAt: &past},
+		{Source: "group:sre", Destination: "tag:prod", Ports: "*", State: TemporaryGrantPending},
+		{Source: "group:dev", Destination: "tag:prod", Ports: "*", State: TemporaryGrantRevoked, ExpiresAt: &future},
+	})
+
+	expected := []ionscale.ACLEntry{
+		{Action: "accept", Source: []string{"john@example.com"}, Destination: []string{"tag:prod:22"}},
+	}
+
+	assert.Equal(t, expected, policy.ACLs)
+}
+
+func TestTemporaryGrant_Validate(t *testing.T) {
+	policy := &ACLPolicy{ionscale.ACLPolicy{
+		Groups:    map[string][]string{"group:dba": {"jane@example.com"}},
+		Hosts:     map[string]string{"db": "10.0.0.10"},
+		TagOwners: map[string][]string{"tag:prod": {"group:dba"}},
+	}}
+
+	valid := TemporaryGrant{Source: "group:sre", Destination: "tag:prod", Ports: "22,8000-8080", Duration: 2 * time.Hour}
+	assert.NoError(t, valid.Validate(policy))
+
+	invalidSource := valid
+	invalidSource.Source = "tag:ci"
+	assert.Error(t, invalidSource.Validate(policy))
+
+	invalidPorts := valid
+	invalidPorts.Ports = "ssh"
+	assert.Error(t, invalidPorts.Validate(policy))
+
+	invalidDuration := valid
+	invalidDuration.Duration = 0
+	assert.Error(t, invalidDuration.Validate(policy))
+
+	for _, dst := range []string{"*", "autogroup:member", "john@example.com", "group:dba", "tag:prod", "db", "10.0.0.1", "10.0.0.0/24", "fd7a:115c:a1e0::1"} {
+		destination := valid
+		destination.Destination = dst
+		assert.NoError(t, destination.Validate(policy), dst)
+	}
+
+	for _, dst := range []string{"", "tag:unknown", "group:unknown", "unknown-host", "10.0.0.0/33"} {
+		destination := valid
+		destination.Destination = dst
+		assert.Error(t, destination.Validate(policy), dst)
+	}
+}
@@ -29,23 +29,52 @@ const (
 type UserRole string
 
 const (
-	UserRoleNone   UserRole = ""
-	UserRoleMember UserRole = "member"
-	UserRoleAdmin  UserRole = "admin"
+	UserRoleNone         UserRole = ""
+	UserRoleMember       UserRole = "member"
+	UserRoleAdmin        UserRole = "admin"
+	UserRoleAuditor      UserRole = "auditor"
+	UserRoleNetworkAdmin UserRole = "network-admin"
+	UserRoleITAdmin      UserRole = "it-admin"
 )
 
+func (s UserRole) IsValid() bool {
+	switch s {
+	case UserRoleMember, UserRoleAdmin, UserRoleAuditor, UserRoleNetworkAdmin, UserRoleITAdmin:
+		return true
+	}
+	return false
+}
+
 func (s UserRole) IsAdmin() bool {
 	return s == UserRoleAdmin
 }
 
+// IsAuditor checks if the role can read the configuration of a tailnet, which all roles except member can
+func (s UserRole) IsAuditor() bool {
+	return s == UserRoleAdmin || s == UserRoleAuditor || s == UserRoleNetworkAdmin || s == UserRoleITAdmin
+}
+
+// IsNetworkAdmin checks if the role can manage the ACL policy, DNS, routes and DERP map of a tailnet
+func (s UserRole) IsNetworkAdmin() bool {
+	return s == UserRoleAdmin || s == UserRoleNetworkAdmin
+}
+
+// IsITAdmin checks if the role can manage the machines, users and auth keys of a tailnet
+func (s UserRole) IsITAdmin() bool {
+	return s == UserRoleAdmin || s == UserRoleITAdmin
+}
+
 type UserRepository interface {
 	GetOrCreateServiceUser(ctx context.Context, tailnet *Tailnet) (*User, bool, error)
 	GetOrCreateUserWithAccount(ctx context.Context, tailnet *Tailnet, account *Account) (*User, bool, error)
 	GetUser(ctx context.Context, userID uint64) (*User, error)
+	GetUserByName(ctx context.Context, tailnetID uint64, name string) (*User, error)
+	SaveUser(ctx context.Context, user *User) error
 	DeleteUser(ctx context.Context, userID uint64) error
 	ListUsers(ctx context.Context, tailnetID uint64) (Users, error)
 	DeleteUsersByTailnet(ctx context.Context, tailnetID uint64) error
 	SetUserLastAuthenticated(ctx context.Context, userID uint64, timestamp time.Time) error
+	ListInvitedTailnetIDs(ctx context.Context, accountID uint64) ([]uint64, error)
 }
 
 type User struct {
@@ -53,10 +82,16 @@ type User struct {
 	Name              string
 	UserType          UserType
 	LastAuthenticated *time.Time
+	ExternalID        string
+	Suspended         bool
 	TailnetID         uint64
 	Tailnet           Tailnet
 	AccountID         *uint64
 	Account           *Account
+
+	// InviteID is set for users admitted by an invite, InviteRole is the role granted by that invite
+	InviteID   *uint64
+	InviteRole UserRole
 }
 
 type Users []User
@@ -98,6 +133,19 @@ func (r *repository) GetOrCreateUserWithAccount(ctx context.Context, tailnet *Ta
 	user := &User{}
 	id := util.NextID()
 
+	// users provisioned upfront (e.g. via SCIM) don't have an account yet, link them on first login
+	if tailnet.AuthProviders.LinksProvisioned(account.Provider) {
+		tx := r.withContext(ctx).
+			Model(User{}).
+			Where("tailnet_id = ? AND LOWER(name) = LOWER(?) AND user_type = ? AND account_id IS NULL", tailnet.ID, account.LoginName, UserTypePerson).
+			Where("NOT EXISTS (SELECT 1 FROM users u WHERE u.tailnet_id = ? AND u.account_id = ?)", tailnet.ID, account.ID).
+			Updates(map[string]interface{}{"account_id": account.ID})
+
+		if tx.Error != nil {
+			return nil, false, tx.Error
+		}
+	}
+
 	query := User{AccountID: &account.ID, TailnetID: tailnet.ID}
 	attrs := User{ID: id, Name: account.LoginName, TailnetID: tailnet.ID, AccountID: &account.ID, UserType: UserTypePerson}
 
@@ -110,6 +158,31 @@ func (r *repository) GetOrCreateUserWithAccount(ctx context.Context, tailnet *Ta
 	return user, user.ID == id, nil
 }
 
+func (r *repository) SaveUser(ctx context.Context, user *User) error {
+	tx := r.withContext(ctx).Save(user)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetUserByName(ctx context.Context, tailnetID uint64, name string) (*User, error) {
+	var m User
+	tx := r.withContext(ctx).Take(&m, "tailnet_id = ? AND name = ? AND user_type = ?", tailnetID, name, UserTypePerson)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &m, nil
+}
+
 func (r *repository) GetUser(ctx context.Context, userID uint64) (*User, error) {
 	var m User
 	tx := r.withContext(ctx).Preload("Tailnet").Preload("Account").Take(&m, "id = ?", userID)
@@ -142,3 +215,19 @@ func (r *repository) SetUserLastAuthenticated(ctx context.Context, userID uint64
 
 	return nil
 }
+
+// ListInvitedTailnetIDs lists the tailnets an account was admitted to by an invite
+func (r *repository) ListInvitedTailnetIDs(ctx context.Context, accountID uint64) ([]uint64, error) {
+	var ids = []uint64{}
+
+	tx := r.withContext(ctx).
+		Model(&User{}).
+		Where("account_id = ? AND invite_id IS NOT NULL", accountID).
+		Pluck("tailnet_id", &ids)
+
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return ids, nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jsiebens/ionscale/internal/addr"
 	"github.com/jsiebens/ionscale/internal/auth"
@@ -10,6 +11,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/mr-tron/base58"
 	"net/http"
+	"slices"
 	"tailscale.com/tailcfg"
 	"time"
 
@@ -22,13 +24,13 @@ import (
 
 func NewAuthenticationHandlers(
 	config *config.Config,
-	authProvider auth.Provider,
+	authProviders auth.Providers,
 	systemIAMPolicy *domain.IAMPolicy,
 	repository domain.Repository) *AuthenticationHandlers {
 
 	return &AuthenticationHandlers{
 		config:          config,
-		authProvider:    authProvider,
+		authProviders:   authProviders,
 		repository:      repository,
 		systemIAMPolicy: systemIAMPolicy,
 	}
@@ -36,16 +38,17 @@ func NewAuthenticationHandlers(
 
 type AuthenticationHandlers struct {
 	repository      domain.Repository
-	authProvider    auth.Provider
+	authProviders   auth.Providers
 	config          *config.Config
 	systemIAMPolicy *domain.IAMPolicy
 }
 
 type AuthInput struct {
-	Key     string   `param:"key"`
-	Flow    AuthFlow `param:"flow"`
-	AuthKey string   `query:"ak" form:"ak"`
-	Oidc    bool     `query:"oidc" form:"oidc"`
+	Key      string   `param:"key"`
+	Flow     AuthFlow `param:"flow"`
+	AuthKey  string   `query:"ak" form:"ak"`
+	Oidc     bool     `query:"oidc" form:"oidc"`
+	Provider string   `query:"p" form:"p"`
 }
 
 type EndAuthForm struct {
@@ -57,8 +60,9 @@ type EndAuthForm struct {
 }
 
 type oauthState struct {
-	Key  string
-	Flow AuthFlow
+	Key      string
+	Flow     AuthFlow
+	Provider string
 }
 
 type AuthFlow string
@@ -67,6 +71,8 @@ const (
 	AuthFlowMachineRegistration = "r"
 	AuthFlowClient              = "c"
 	AuthFlowSSHCheckFlow        = "s"
+	AuthFlowConsole             = "w"
+	AuthFlowInvite              = "i"
 )
 
 func (h *AuthenticationHandlers) StartAuth(c echo.Context) error {
@@ -84,16 +90,9 @@ func (h *AuthenticationHandlers) StartAuth(c echo.Context) error {
 			return logError(err)
 		}
 
-		if input.Oidc && h.authProvider != nil {
-			goto startOidc
-		}
-
 		if input.AuthKey != "" {
 			return h.endMachineRegistrationFlow(c, EndAuthForm{AuthKey: input.AuthKey}, req)
 		}
-
-		csrf := c.Get(middleware.DefaultCSRFConfig.ContextKey).(string)
-		return c.Render(http.StatusOK, "", tpl.Auth(h.authProvider != nil, csrf))
 	}
 
 	// cli auth flow
@@ -110,20 +109,35 @@ func (h *AuthenticationHandlers) StartAuth(c echo.Context) error {
 		}
 	}
 
-	if h.authProvider == nil {
-		return logError(fmt.Errorf("unable to start auth flow as no auth provider is configured"))
+	// invite flow
+	if input.Flow == AuthFlowInvite {
+		invite, err := h.repository.LoadInvite(ctx, input.Key)
+		if err != nil {
+			return logError(err)
+		}
+		if invite == nil || !invite.IsUsable(time.Now().UTC()) {
+			return c.Redirect(http.StatusFound, "/a/error?e=ii")
+		}
 	}
 
-startOidc:
+	// web console auth flow
+	if input.Flow == AuthFlowConsole {
+		if cookie, err := c.Cookie(consoleLoginCookie); err != nil || cookie.Value != input.Key {
+			return c.Redirect(http.StatusFound, "/console/login")
+		}
+	}
 
-	state, err := h.createState(input.Flow, input.Key)
-	if err != nil {
-		return logError(err)
+	if input.Flow != AuthFlowMachineRegistration && len(h.authProviders) == 0 {
+		return logError(fmt.Errorf("unable to start auth flow as no auth provider is configured"))
 	}
 
-	redirectUrl := h.authProvider.GetLoginURL(h.config.CreateUrl("/a/callback"), state)
+	provider := h.selectAuthProvider(input)
+	if provider == nil {
+		csrf := c.Get(middleware.DefaultCSRFConfig.ContextKey).(string)
+		return c.Render(http.StatusOK, "", tpl.Auth(h.authProviders, input.Flow == AuthFlowMachineRegistration, csrf))
+	}
 
-	return c.Redirect(http.StatusFound, redirectUrl)
+	return h.startOidc(c, provider, input)
 }
 
 func (h *AuthenticationHandlers) ProcessAuth(c echo.Context) error {
@@ -134,27 +148,47 @@ func (h *AuthenticationHandlers) ProcessAuth(c echo.Context) error {
 		return logError(err)
 	}
 
-	req, err := h.repository.GetRegistrationRequestByKey(ctx, input.Key)
-	if err != nil || req == nil {
-		return logError(err)
+	if input.Flow == AuthFlowMachineRegistration {
+		req, err := h.repository.GetRegistrationRequestByKey(ctx, input.Key)
+		if err != nil || req == nil {
+			return logError(err)
+		}
+
+		if input.AuthKey != "" {
+			return h.endMachineRegistrationFlow(c, EndAuthForm{AuthKey: input.AuthKey}, req)
+		}
 	}
 
-	if input.AuthKey != "" {
-		return h.endMachineRegistrationFlow(c, EndAuthForm{AuthKey: input.AuthKey}, req)
+	if provider := h.selectAuthProvider(input); provider != nil {
+		return h.startOidc(c, provider, input)
 	}
 
-	if input.Oidc {
-		state, err := h.createState(input.Flow, input.Key)
-		if err != nil {
-			return logError(err)
-		}
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/a/%s/%s", input.Flow, input.Key))
+}
 
-		redirectUrl := h.authProvider.GetLoginURL(h.config.CreateUrl("/a/callback"), state)
+// selectAuthProvider returns the provider explicitly chosen by the user, or the only configured provider when
+// there is nothing to choose from. It returns nil when the user should be presented the login options.
+func (h *AuthenticationHandlers) selectAuthProvider(input AuthInput) auth.Provider {
+	if input.Provider != "" {
+		return h.authProviders.Get(input.Provider)
+	}
 
-		return c.Redirect(http.StatusFound, redirectUrl)
+	if len(h.authProviders) == 1 && (input.Oidc || input.Flow != AuthFlowMachineRegistration) {
+		return h.authProviders[0]
 	}
 
-	return c.Redirect(http.StatusFound, fmt.Sprintf("/a/%s/%s", input.Flow, input.Key))
+	return nil
+}
+
+func (h *AuthenticationHandlers) startOidc(c echo.Context, provider auth.Provider, input AuthInput) error {
+	state, err := h.createState(input.Flow, input.Key, provider.Name())
+	if err != nil {
+		return logError(err)
+	}
+
+	redirectUrl := provider.GetLoginURL(h.config.CreateUrl("/a/callback"), state)
+
+	return c.Redirect(http.StatusFound, redirectUrl)
 }
 
 func (h *AuthenticationHandlers) Callback(c echo.Context) error {
@@ -166,12 +200,21 @@ func (h *AuthenticationHandlers) Callback(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid state parameter")
 	}
 
-	user, err := h.exchangeUser(code)
+	provider := h.authProviders.Get(state.Provider)
+	if provider == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid state parameter")
+	}
+
+	user, err := h.exchangeUser(provider, code)
 	if err != nil {
 		return logError(err)
 	}
 
-	account, _, err := h.repository.GetOrCreateAccount(ctx, user.ID, user.Name)
+	if user.Attr != nil {
+		user.Attr["provider"] = provider.Name()
+	}
+
+	account, _, err := h.repository.GetOrCreateAccount(ctx, provider.Name(), user.ID, user.Name)
 	if err != nil {
 		return logError(err)
 	}
@@ -217,7 +260,11 @@ func (h *AuthenticationHandlers) Callback(c echo.Context) error {
 		return c.Redirect(http.StatusFound, "/a/error?e=nmo")
 	}
 
-	tailnets, err := h.listAvailableTailnets(ctx, user)
+	if state.Flow == AuthFlowInvite {
+		return h.acceptInvite(c, state.Key, account)
+	}
+
+	tailnets, err := h.listAvailableTailnets(ctx, provider.Name(), account, user)
 	if err != nil {
 		return logError(err)
 	}
@@ -249,7 +296,7 @@ func (h *AuthenticationHandlers) Callback(c echo.Context) error {
 	}
 
 	if state.Flow == AuthFlowClient {
-		isSystemAdmin, err := h.isSystemAdmin(user)
+		isSystemAdmin, err := h.isSystemAdmin(provider.Name(), user)
 		if err != nil {
 			return logError(err)
 		}
@@ -266,6 +313,36 @@ func (h *AuthenticationHandlers) Callback(c echo.Context) error {
 		return c.Render(http.StatusOK, "", tpl.Tailnets(account.ID, isSystemAdmin, tailnets, csrf))
 	}
 
+	if state.Flow == AuthFlowConsole {
+		req, err := h.repository.GetAuthenticationRequest(ctx, state.Key)
+		if err != nil || req == nil {
+			return logError(err)
+		}
+
+		isSystemAdmin, err := h.isSystemAdmin(provider.Name(), user)
+		if err != nil {
+			return logError(err)
+		}
+
+		if !isSystemAdmin && len(tailnets) == 0 {
+			return c.Redirect(http.StatusFound, "/a/error?e=ua")
+		}
+
+		// the selection form is posted back by the browser, remember what this account is allowed to choose
+		req.AccountID = &account.ID
+		req.SystemAdmin = isSystemAdmin
+		req.TailnetIDs = domain.TailnetIDs{}
+		for _, t := range tailnets {
+			req.TailnetIDs = append(req.TailnetIDs, t.ID)
+		}
+
+		if err := h.repository.SaveAuthenticationRequest(ctx, req); err != nil {
+			return logError(err)
+		}
+
+		return c.Render(http.StatusOK, "", tpl.Tailnets(account.ID, isSystemAdmin, tailnets, csrf))
+	}
+
 	return echo.NewHTTPError(http.StatusNotFound)
 }
 
@@ -300,6 +377,15 @@ func (h *AuthenticationHandlers) EndAuth(c echo.Context) error {
 		return h.endCliAuthenticationFlow(c, form, req)
 	}
 
+	if state.Flow == AuthFlowConsole {
+		req, err := h.repository.GetAuthenticationRequest(ctx, state.Key)
+		if err != nil || req == nil {
+			return logError(err)
+		}
+
+		return h.endConsoleFlow(c, form, req)
+	}
+
 	return echo.NewHTTPError(http.StatusBadRequest, "Invalid state parameter")
 }
 
@@ -308,6 +394,8 @@ func (h *AuthenticationHandlers) Success(c echo.Context) error {
 	switch s {
 	case "nma":
 		return c.Render(http.StatusOK, "", tpl.NewMachine())
+	case "inv":
+		return c.Render(http.StatusOK, "", tpl.InviteAccepted())
 	}
 	return c.Render(http.StatusOK, "", tpl.Success())
 }
@@ -323,6 +411,8 @@ func (h *AuthenticationHandlers) Error(c echo.Context) error {
 		return c.Render(http.StatusForbidden, "", tpl.NotTagOwner())
 	case "nmo":
 		return c.Render(http.StatusForbidden, "", tpl.NotMachineOwner())
+	case "ii":
+		return c.Render(http.StatusForbidden, "", tpl.InvalidInvite())
 	}
 	return c.Render(http.StatusOK, "", tpl.Error())
 }
@@ -361,11 +451,27 @@ func (h *AuthenticationHandlers) endCliAuthenticationFlow(c echo.Context, form E
 		return logError(err)
 	}
 
+	if !tailnet.AuthProviders.Allows(account.Provider) {
+		req.Error = "unauthorized"
+		if err := h.repository.SaveAuthenticationRequest(ctx, req); err != nil {
+			return logError(err)
+		}
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
 	user, _, err := h.repository.GetOrCreateUserWithAccount(ctx, tailnet, account)
 	if err != nil {
 		return logError(err)
 	}
 
+	if user.Suspended {
+		req.Error = "unauthorized"
+		if err := h.repository.SaveAuthenticationRequest(ctx, req); err != nil {
+			return logError(err)
+		}
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
 	expiresAt := time.Now().Add(24 * time.Hour)
 	token, apiKey := domain.CreateApiKey(tailnet, user, &expiresAt)
 	req.Token = token
@@ -390,6 +496,134 @@ func (h *AuthenticationHandlers) endCliAuthenticationFlow(c echo.Context, form E
 	return c.Redirect(http.StatusFound, "/a/success")
 }
 
+func (h *AuthenticationHandlers) endConsoleFlow(c echo.Context, form EndAuthForm, req *domain.AuthenticationRequest) error {
+	ctx := c.Request().Context()
+
+	cookie, err := c.Cookie(consoleLoginCookie)
+	if err != nil || cookie.Value != req.Key || req.AccountID == nil || *req.AccountID != form.AccountID {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid state parameter")
+	}
+
+	if err := h.repository.DeleteAuthenticationRequest(ctx, req.Key); err != nil {
+		return logError(err)
+	}
+
+	account, err := h.repository.GetAccount(ctx, *req.AccountID)
+	if err != nil || account == nil {
+		return logError(err)
+	}
+
+	expiresAt := time.Now().Add(consoleSessionDuration)
+
+	if form.AsSystemAdmin {
+		if !req.SystemAdmin {
+			return c.Redirect(http.StatusFound, "/a/error?e=ua")
+		}
+
+		token, apiKey := domain.CreateSystemApiKey(account, &expiresAt)
+		if err := h.repository.SaveSystemApiKey(ctx, apiKey); err != nil {
+			return logError(err)
+		}
+
+		return startConsoleSession(c, h.config, token, expiresAt)
+	}
+
+	if !slices.Contains(req.TailnetIDs, form.TailnetID) {
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
+	tailnet, err := h.repository.GetTailnet(ctx, form.TailnetID)
+	if err != nil || tailnet == nil {
+		return logError(err)
+	}
+
+	user, _, err := h.repository.GetOrCreateUserWithAccount(ctx, tailnet, account)
+	if err != nil {
+		return logError(err)
+	}
+
+	if user.Suspended {
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
+	token, apiKey := domain.CreateApiKey(tailnet, user, &expiresAt)
+
+	err = h.repository.Transaction(func(rp domain.Repository) error {
+		if err := rp.SetUserLastAuthenticated(ctx, user.ID, time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := rp.SaveApiKey(ctx, apiKey); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return logError(err)
+	}
+
+	return startConsoleSession(c, h.config, token, expiresAt)
+}
+
+var errInviteUsedUp = errors.New("invite used up")
+
+// acceptInvite admits the authenticated account into the tailnet of the invite, regardless of the IAM policy of the tailnet.
+// The invite is recorded on the user, so the user keeps access to the tailnet on later logins.
+func (h *AuthenticationHandlers) acceptInvite(c echo.Context, value string, account *domain.Account) error {
+	ctx := c.Request().Context()
+
+	invite, err := h.repository.LoadInvite(ctx, value)
+	if err != nil {
+		return logError(err)
+	}
+
+	if invite == nil || !invite.IsUsable(time.Now().UTC()) {
+		return c.Redirect(http.StatusFound, "/a/error?e=ii")
+	}
+
+	if !invite.Allows(account.LoginName) || !invite.Tailnet.AuthProviders.Allows(account.Provider) {
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
+	user, _, err := h.repository.GetOrCreateUserWithAccount(ctx, &invite.Tailnet, account)
+	if err != nil {
+		return logError(err)
+	}
+
+	if user.Suspended {
+		return c.Redirect(http.StatusFound, "/a/error?e=ua")
+	}
+
+	// opening the same invite link again should not count as another use
+	if user.InviteID != nil && *user.InviteID == invite.ID {
+		return c.Redirect(http.StatusFound, "/a/success?s=inv")
+	}
+
+	err = h.repository.Transaction(func(rp domain.Repository) error {
+		claimed, err := rp.ClaimInvite(ctx, invite.ID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return errInviteUsedUp
+		}
+
+		user.InviteID = &invite.ID
+		user.InviteRole = invite.Role
+
+		return rp.SaveUser(ctx, user)
+	})
+
+	if errors.Is(err, errInviteUsedUp) {
+		return c.Redirect(http.StatusFound, "/a/error?e=ii")
+	}
+
+	if err != nil {
+		return logError(err)
+	}
+
+	return c.Redirect(http.StatusFound, "/a/success?s=inv")
+}
+
 func (h *AuthenticationHandlers) endMachineRegistrationFlow(c echo.Context, form EndAuthForm, registrationRequest *domain.RegistrationRequest) error {
 	ctx := c.Request().Context()
 
@@ -421,6 +655,17 @@ func (h *AuthenticationHandlers) endMachineRegistrationFlow(c echo.Context, form
 			return c.Redirect(http.StatusFound, "/a/error?e=iak")
 		}
 
+		if authKey.User.Suspended {
+			registrationRequest.Authenticated = false
+			registrationRequest.Error = "unauthorized"
+
+			if err := h.repository.SaveRegistrationRequest(ctx, registrationRequest); err != nil {
+				return logError(err)
+			}
+
+			return c.Redirect(http.StatusFound, "/a/error?e=ua")
+		}
+
 		tailnet = &authKey.Tailnet
 		user = &authKey.User
 		tags = authKey.Tags
@@ -437,17 +682,40 @@ func (h *AuthenticationHandlers) endMachineRegistrationFlow(c echo.Context, form
 			return logError(err)
 		}
 
+		if !selectedTailnet.AuthProviders.Allows(account.Provider) {
+			registrationRequest.Authenticated = false
+			registrationRequest.Error = "unauthorized"
+			if err := h.repository.SaveRegistrationRequest(ctx, registrationRequest); err != nil {
+				return logError(err)
+			}
+			return c.Redirect(http.StatusFound, "/a/error?e=ua")
+		}
+
 		selectedUser, _, err := h.repository.GetOrCreateUserWithAccount(ctx, selectedTailnet, account)
 		if err != nil {
 			return logError(err)
 		}
 
+		if selectedUser.Suspended {
+			registrationRequest.Authenticated = false
+			registrationRequest.Error = "unauthorized"
+			if err := h.repository.SaveRegistrationRequest(ctx, registrationRequest); err != nil {
+				return logError(err)
+			}
+			return c.Redirect(http.StatusFound, "/a/error?e=ua")
+		}
+
 		user = selectedUser
 		tailnet = selectedTailnet
 		ephemeral = false
 	}
 
-	if err := tailnet.ACLPolicy.Get().CheckTagOwners(registrationRequest.Data.Hostinfo.RequestTags, user); err != nil {
+	policy, err := h.repository.GetACLPolicy(ctx, tailnet)
+	if err != nil {
+		return logError(err)
+	}
+
+	if err := policy.CheckTagOwners(registrationRequest.Data.Hostinfo.RequestTags, user); err != nil {
 		registrationRequest.Authenticated = false
 		registrationRequest.Error = err.Error()
 		if err := h.repository.SaveRegistrationRequest(ctx, registrationRequest); err != nil {
@@ -456,11 +724,11 @@ func (h *AuthenticationHandlers) endMachineRegistrationFlow(c echo.Context, form
 		return c.Redirect(http.StatusFound, "/a/error?e=nto")
 	}
 
-	autoAllowIPs := tailnet.ACLPolicy.Get().FindAutoApprovedIPs(req.Hostinfo.RoutableIPs, tags, user)
+	autoAllowIPs := policy.FindAutoApprovedIPs(req.Hostinfo.RoutableIPs, tags, user)
 
 	var m *domain.Machine
 
-	m, err := h.repository.GetMachineByKeyAndUser(ctx, machineKey, user.ID)
+	m, err = h.repository.GetMachineByKeyAndUser(ctx, machineKey, user.ID)
 	if err != nil {
 		return logError(err)
 	}
@@ -563,18 +831,29 @@ func (h *AuthenticationHandlers) endMachineRegistrationFlow(c echo.Context, form
 	}
 }
 
-func (h *AuthenticationHandlers) isSystemAdmin(u *auth.User) (bool, error) {
-	return h.systemIAMPolicy.EvaluatePolicy(&domain.Identity{UserID: u.ID, Email: u.Name, Attr: u.Attr})
+func (h *AuthenticationHandlers) isSystemAdmin(provider string, u *auth.User) (bool, error) {
+	return h.systemIAMPolicy.EvaluatePolicy(&domain.Identity{Provider: provider, UserID: u.ID, Email: u.Name, Attr: u.Attr})
 }
 
-func (h *AuthenticationHandlers) listAvailableTailnets(ctx context.Context, u *auth.User) ([]domain.Tailnet, error) {
+func (h *AuthenticationHandlers) listAvailableTailnets(ctx context.Context, provider string, account *domain.Account, u *auth.User) ([]domain.Tailnet, error) {
 	var result = []domain.Tailnet{}
 	tailnets, err := h.repository.ListTailnets(ctx)
 	if err != nil {
 		return nil, err
 	}
+	invited, err := h.repository.ListInvitedTailnetIDs(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
 	for _, t := range tailnets {
-		approved, err := t.IAMPolicy.Get().EvaluatePolicy(&domain.Identity{UserID: u.ID, Email: u.Name, Attr: u.Attr})
+		if !t.AuthProviders.Allows(provider) {
+			continue
+		}
+		if slices.Contains(invited, t.ID) {
+			result = append(result, t)
+			continue
+		}
+		approved, err := t.IAMPolicy.Get().EvaluatePolicy(&domain.Identity{Provider: provider, UserID: u.ID, Email: u.Name, Attr: u.Attr})
 		if err != nil {
 			return nil, err
 		}
@@ -585,10 +864,10 @@ func (h *AuthenticationHandlers) listAvailableTailnets(ctx context.Context, u *a
 	return result, nil
 }
 
-func (h *AuthenticationHandlers) exchangeUser(code string) (*auth.User, error) {
+func (h *AuthenticationHandlers) exchangeUser(provider auth.Provider, code string) (*auth.User, error) {
 	redirectUrl := h.config.CreateUrl("/a/callback")
 
-	user, err := h.authProvider.Exchange(redirectUrl, code)
+	user, err := provider.Exchange(redirectUrl, code)
 	if err != nil {
 		return nil, err
 	}
@@ -596,8 +875,8 @@ func (h *AuthenticationHandlers) exchangeUser(code string) (*auth.User, error) {
 	return user, nil
 }
 
-func (h *AuthenticationHandlers) createState(flow AuthFlow, key string) (string, error) {
-	stateMap := oauthState{Key: key, Flow: flow}
+func (h *AuthenticationHandlers) createState(flow AuthFlow, key string, provider string) (string, error) {
+	stateMap := oauthState{Key: key, Flow: flow, Provider: provider}
 	marshal, err := json.Marshal(&stateMap)
 	if err != nil {
 		return "", err
@@ -0,0 +1,531 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/service"
+	tpl "github.com/jsiebens/ionscale/internal/templates"
+	"github.com/jsiebens/ionscale/internal/util"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	apiconnect "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1/ionscalev1connect"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	str2dur "github.com/xhit/go-str2duration/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	consoleSessionCookie   = "ionscale_console"
+	consoleLoginCookie     = "ionscale_console_login"
+	consoleSessionDuration = 12 * time.Hour
+	consoleLoginDuration   = 15 * time.Minute
+)
+
+func NewConsoleHandlers(config *config.Config, repository domain.Repository, service apiconnect.IonscaleServiceHandler) *ConsoleHandlers {
+	return &ConsoleHandlers{
+		config:     config,
+		repository: repository,
+		service:    service,
+	}
+}
+
+// ConsoleHandlers serves the admin web console. All operations go through the same service as the RPC API,
+// authenticated with an API key issued by the web login flow and stored in a cookie.
+type ConsoleHandlers struct {
+	config     *config.Config
+	repository domain.Repository
+	service    apiconnect.IonscaleServiceHandler
+}
+
+type consoleTailnetInput struct {
+	TailnetID uint64 `param:"tid"`
+}
+
+type consoleMachineInput struct {
+	TailnetID uint64   `param:"tid"`
+	MachineID uint64   `param:"mid"`
+	Routes    []string `form:"routes"`
+	ExitNode  bool     `form:"exit_node"`
+}
+
+type consoleAuthKeyInput struct {
+	TailnetID     uint64 `param:"tid"`
+	AuthKeyID     uint64 `param:"kid"`
+	Ephemeral     bool   `form:"ephemeral"`
+	PreAuthorized bool   `form:"pre_authorized"`
+	Tags          string `form:"tags"`
+	Expiry        string `form:"expiry"`
+}
+
+type consolePolicyInput struct {
+	TailnetID uint64 `param:"tid"`
+	Policy    string `param:"policy"`
+	Content   string `form:"content"`
+}
+
+func (h *ConsoleHandlers) Login(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	key := util.RandStringBytes(8)
+	req := &domain.AuthenticationRequest{
+		Key:       key,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := h.repository.SaveAuthenticationRequest(ctx, req); err != nil {
+		return logError(err)
+	}
+
+	setConsoleCookie(c, h.config, consoleLoginCookie, key, time.Now().Add(consoleLoginDuration))
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/a/%s/%s", AuthFlowConsole, key))
+}
+
+// Logout revokes the API key of the console session, as the key is also valid for the RPC API,
+// and clears the session cookie
+func (h *ConsoleHandlers) Logout(c echo.Context) error {
+	if cookie, err := c.Cookie(consoleSessionCookie); err == nil && cookie.Value != "" {
+		if err := h.revokeSessionKey(c.Request().Context(), cookie.Value); err != nil {
+			return logError(err)
+		}
+	}
+
+	setConsoleCookie(c, h.config, consoleSessionCookie, "", time.Unix(0, 0))
+	return c.Redirect(http.StatusFound, "/")
+}
+
+func (h *ConsoleHandlers) revokeSessionKey(ctx context.Context, token string) error {
+	apiKey, err := h.repository.LoadApiKey(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if apiKey != nil {
+		_, err := h.repository.DeleteApiKey(ctx, apiKey.ID)
+		return err
+	}
+
+	systemApiKey, err := h.repository.LoadSystemApiKey(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if systemApiKey != nil {
+		_, err := h.repository.DeleteSystemApiKey(ctx, systemApiKey.ID)
+		return err
+	}
+
+	return nil
+}
+
+func (h *ConsoleHandlers) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		cookie, err := c.Cookie(consoleSessionCookie)
+		if err != nil || cookie.Value == "" {
+			return c.Redirect(http.StatusFound, "/console/login")
+		}
+
+		ctx, ok := service.ContextWithPrincipal(c.Request().Context(), h.repository, cookie.Value)
+		if !ok {
+			return c.Redirect(http.StatusFound, "/console/login")
+		}
+
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
+
+func (h *ConsoleHandlers) Index(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	principal := service.CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && principal.User != nil {
+		return c.Redirect(http.StatusFound, fmt.Sprintf("/console/tailnets/%d/machines", principal.User.TailnetID))
+	}
+
+	resp, err := h.service.ListTailnets(ctx, connect.NewRequest(&api.ListTailnetsRequest{}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	return c.Render(http.StatusOK, "console", tpl.ConsoleTailnets(resp.Msg.Tailnet, h.csrf(c)))
+}
+
+func (h *ConsoleHandlers) ListMachines(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleTailnetInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	tailnet, err := h.getTailnet(ctx, input.TailnetID)
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	resp, err := h.service.ListMachines(ctx, connect.NewRequest(&api.ListMachinesRequest{TailnetId: input.TailnetID}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	return c.Render(http.StatusOK, "console", tpl.ConsoleMachines(tailnet, resp.Msg.Machines, h.csrf(c)))
+}
+
+func (h *ConsoleHandlers) GetMachine(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleMachineInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	tailnet, err := h.getTailnet(ctx, input.TailnetID)
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	resp, err := h.service.GetMachine(ctx, connect.NewRequest(&api.GetMachineRequest{MachineId: input.MachineID}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	if resp.Msg.Machine.Tailnet.Id != tailnet.Id {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	return c.Render(http.StatusOK, "console", tpl.ConsoleMachine(tailnet, resp.Msg.Machine, h.csrf(c)))
+}
+
+func (h *ConsoleHandlers) AuthorizeMachine(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleMachineInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	if err := h.checkMachineTailnet(ctx, input); err != nil {
+		return h.renderError(c, err)
+	}
+
+	if _, err := h.service.AuthorizeMachine(ctx, connect.NewRequest(&api.AuthorizeMachineRequest{MachineId: input.MachineID})); err != nil {
+		return h.renderError(c, err)
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/console/tailnets/%d/machines/%d", input.TailnetID, input.MachineID))
+}
+
+// UpdateMachineRoutes enables the advertised routes checked in the form and disables all others
+func (h *ConsoleHandlers) UpdateMachineRoutes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleMachineInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	if err := h.checkMachineTailnet(ctx, input); err != nil {
+		return h.renderError(c, err)
+	}
+
+	resp, err := h.service.GetMachineRoutes(ctx, connect.NewRequest(&api.GetMachineRoutesRequest{MachineId: input.MachineID}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	routes := resp.Msg.Routes
+
+	var enable = []string{}
+	var disable = []string{}
+	for _, r := range routes.AdvertisedRoutes {
+		if slices.Contains(input.Routes, r) {
+			enable = append(enable, r)
+		} else {
+			disable = append(disable, r)
+		}
+	}
+
+	if len(disable) != 0 {
+		if _, err := h.service.DisableMachineRoutes(ctx, connect.NewRequest(&api.DisableMachineRoutesRequest{MachineId: input.MachineID, Routes: disable})); err != nil {
+			return h.renderError(c, err)
+		}
+	}
+
+	if len(enable) != 0 {
+		if _, err := h.service.EnableMachineRoutes(ctx, connect.NewRequest(&api.EnableMachineRoutesRequest{MachineId: input.MachineID, Routes: enable})); err != nil {
+			return h.renderError(c, err)
+		}
+	}
+
+	if routes.AdvertisedExitNode && input.ExitNode != routes.EnabledExitNode {
+		if input.ExitNode {
+			_, err = h.service.EnableExitNode(ctx, connect.NewRequest(&api.EnableExitNodeRequest{MachineId: input.MachineID}))
+		} else {
+			_, err = h.service.DisableExitNode(ctx, connect.NewRequest(&api.DisableExitNodeRequest{MachineId: input.MachineID}))
+		}
+		if err != nil {
+			return h.renderError(c, err)
+		}
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/console/tailnets/%d/machines/%d", input.TailnetID, input.MachineID))
+}
+
+func (h *ConsoleHandlers) ListAuthKeys(c echo.Context) error {
+	var input consoleAuthKeyInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	return h.renderAuthKeys(c, input.TailnetID, "", "")
+}
+
+func (h *ConsoleHandlers) CreateAuthKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleAuthKeyInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	var expiry *durationpb.Duration
+	if input.Expiry != "" && input.Expiry != "none" {
+		duration, err := str2dur.ParseDuration(input.Expiry)
+		if err != nil {
+			return h.renderAuthKeys(c, input.TailnetID, "", fmt.Sprintf("invalid expiry: %s", err))
+		}
+		expiry = durationpb.New(duration)
+	}
+
+	var tags = []string{}
+	for _, t := range strings.Split(input.Tags, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+
+	req := &api.CreateAuthKeyRequest{
+		TailnetId:     input.TailnetID,
+		Ephemeral:     input.Ephemeral,
+		PreAuthorized: input.PreAuthorized,
+		Tags:          tags,
+		Expiry:        expiry,
+	}
+
+	resp, err := h.service.CreateAuthKey(ctx, connect.NewRequest(req))
+	if err != nil {
+		if msg, ok := invalidRequestMessage(err); ok {
+			return h.renderAuthKeys(c, input.TailnetID, "", msg)
+		}
+		return h.renderError(c, err)
+	}
+
+	return h.renderAuthKeys(c, input.TailnetID, resp.Msg.Value, "")
+}
+
+func (h *ConsoleHandlers) DeleteAuthKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consoleAuthKeyInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	key, err := h.service.GetAuthKey(ctx, connect.NewRequest(&api.GetAuthKeyRequest{AuthKeyId: input.AuthKeyID}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	if key.Msg.AuthKey.Tailnet.Id != input.TailnetID {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	if _, err := h.service.DeleteAuthKey(ctx, connect.NewRequest(&api.DeleteAuthKeyRequest{AuthKeyId: input.AuthKeyID})); err != nil {
+		return h.renderError(c, err)
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/console/tailnets/%d/keys", input.TailnetID))
+}
+
+func (h *ConsoleHandlers) GetPolicy(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consolePolicyInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	var content string
+
+	switch input.Policy {
+	case "acl":
+		resp, err := h.service.GetACLPolicy(ctx, connect.NewRequest(&api.GetACLPolicyRequest{TailnetId: input.TailnetID}))
+		if err != nil {
+			return h.renderError(c, err)
+		}
+		content = resp.Msg.Policy
+	case "iam":
+		resp, err := h.service.GetIAMPolicy(ctx, connect.NewRequest(&api.GetIAMPolicyRequest{TailnetId: input.TailnetID}))
+		if err != nil {
+			return h.renderError(c, err)
+		}
+		content = resp.Msg.Policy
+	case "dns":
+		resp, err := h.service.GetDNSConfig(ctx, connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: input.TailnetID}))
+		if err != nil {
+			return h.renderError(c, err)
+		}
+		content = protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Format(resp.Msg.Config)
+	default:
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	return h.renderPolicy(c, input, content, "", false)
+}
+
+func (h *ConsoleHandlers) SetPolicy(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input consolePolicyInput
+	if err := c.Bind(&input); err != nil {
+		return logError(err)
+	}
+
+	var err error
+
+	switch input.Policy {
+	case "acl":
+		_, err = h.service.SetACLPolicy(ctx, connect.NewRequest(&api.SetACLPolicyRequest{TailnetId: input.TailnetID, Policy: input.Content}))
+	case "iam":
+		_, err = h.service.SetIAMPolicy(ctx, connect.NewRequest(&api.SetIAMPolicyRequest{TailnetId: input.TailnetID, Policy: input.Content}))
+	case "dns":
+		var dnsConfig = &api.DNSConfig{}
+		if err := protojson.Unmarshal([]byte(input.Content), dnsConfig); err != nil {
+			return h.renderPolicy(c, input, input.Content, fmt.Sprintf("invalid dns config: %s", err), false)
+		}
+		_, err = h.service.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{TailnetId: input.TailnetID, Config: dnsConfig}))
+	default:
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	if err != nil {
+		if msg, ok := invalidRequestMessage(err); ok {
+			return h.renderPolicy(c, input, input.Content, msg, false)
+		}
+		return h.renderError(c, err)
+	}
+
+	return h.renderPolicy(c, input, input.Content, "", true)
+}
+
+func (h *ConsoleHandlers) renderAuthKeys(c echo.Context, tailnetID uint64, newKey string, errorMessage string) error {
+	ctx := c.Request().Context()
+
+	tailnet, err := h.getTailnet(ctx, tailnetID)
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	resp, err := h.service.ListAuthKeys(ctx, connect.NewRequest(&api.ListAuthKeysRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	return c.Render(http.StatusOK, "console", tpl.ConsoleAuthKeys(tailnet, resp.Msg.AuthKeys, newKey, errorMessage, h.csrf(c)))
+}
+
+func (h *ConsoleHandlers) renderPolicy(c echo.Context, input consolePolicyInput, content string, errorMessage string, saved bool) error {
+	tailnet, err := h.getTailnet(c.Request().Context(), input.TailnetID)
+	if err != nil {
+		return h.renderError(c, err)
+	}
+
+	code := http.StatusOK
+	if errorMessage != "" {
+		code = http.StatusBadRequest
+	}
+
+	return c.Render(code, "console", tpl.ConsolePolicy(tailnet, input.Policy, content, errorMessage, saved, h.csrf(c)))
+}
+
+// checkMachineTailnet verifies the machine of a form belongs to the tailnet in the url
+func (h *ConsoleHandlers) checkMachineTailnet(ctx context.Context, input consoleMachineInput) error {
+	resp, err := h.service.GetMachine(ctx, connect.NewRequest(&api.GetMachineRequest{MachineId: input.MachineID}))
+	if err != nil {
+		return err
+	}
+
+	if resp.Msg.Machine.Tailnet.Id != input.TailnetID {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
+	}
+
+	return nil
+}
+
+func (h *ConsoleHandlers) getTailnet(ctx context.Context, tailnetID uint64) (*api.Tailnet, error) {
+	resp, err := h.service.GetTailnet(ctx, connect.NewRequest(&api.GetTailnetRequest{Id: tailnetID}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Tailnet, nil
+}
+
+// renderError renders the errors returned by the service, which are already checked for permissions, as a console page
+func (h *ConsoleHandlers) renderError(c echo.Context, err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return logError(err)
+	}
+
+	code := http.StatusInternalServerError
+	switch connectErr.Code() {
+	case connect.CodePermissionDenied, connect.CodeUnauthenticated:
+		code = http.StatusForbidden
+	case connect.CodeNotFound:
+		code = http.StatusNotFound
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition:
+		code = http.StatusBadRequest
+	}
+
+	return c.Render(code, "console", tpl.ConsoleError(connectErr.Message()))
+}
+
+func (h *ConsoleHandlers) csrf(c echo.Context) string {
+	return c.Get(middleware.DefaultCSRFConfig.ContextKey).(string)
+}
+
+func invalidRequestMessage(err error) (string, bool) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && (connectErr.Code() == connect.CodeInvalidArgument || connectErr.Code() == connect.CodeFailedPrecondition) {
+		return connectErr.Message(), true
+	}
+	return "", false
+}
+
+func startConsoleSession(c echo.Context, config *config.Config, token string, expiresAt time.Time) error {
+	setConsoleCookie(c, config, consoleLoginCookie, "", time.Unix(0, 0))
+	setConsoleCookie(c, config, consoleSessionCookie, token, expiresAt)
+	return c.Redirect(http.StatusFound, "/console")
+}
+
+func setConsoleCookie(c echo.Context, config *config.Config, name, value string, expiresAt time.Time) {
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		Secure:   config.PublicUrl.Scheme == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/service"
+	"github.com/jsiebens/ionscale/internal/templates"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+const consoleTestCSRF = "csrf-token"
+
+func newConsoleTestServer(t *testing.T, repository domain.Repository) *echo.Echo {
+	c := &config.Config{PublicUrl: &url.URL{Scheme: "https", Host: "ionscale.example.com"}}
+	h := NewConsoleHandlers(c, repository, service.NewService(c, nil, nil, repository, core.NewPollMapSessionManager(), nil))
+
+	e := echo.New()
+	e.Renderer = &templates.Renderer{}
+
+	csrf := middleware.CSRFWithConfig(middleware.CSRFConfig{TokenLookup: "form:_csrf"})
+	console := e.Group("/console", csrf, h.Middleware)
+	console.POST("/logout", h.Logout)
+	console.GET("/tailnets/:tid/machines", h.ListMachines)
+	console.POST("/tailnets/:tid/machines/:mid/authorize", h.AuthorizeMachine)
+	console.POST("/tailnets/:tid/machines/:mid/routes", h.UpdateMachineRoutes)
+
+	return e
+}
+
+func consoleRequest(e *echo.Echo, method, path, session string, form url.Values) *httptest.ResponseRecorder {
+	if form == nil {
+		form = url.Values{}
+	}
+	form.Set("_csrf", consoleTestCSRF)
+
+	req := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", echo.MIMEApplicationForm)
+	req.AddCookie(&http.Cookie{Name: "_csrf", Value: consoleTestCSRF})
+	if session != "" {
+		req.AddCookie(&http.Cookie{Name: consoleSessionCookie, Value: session})
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func newConsoleSession(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, user *domain.User) string {
+	expiresAt := time.Now().Add(consoleSessionDuration)
+	token, apiKey := domain.CreateApiKey(tailnet, user, &expiresAt)
+	require.NoError(t, repository.SaveApiKey(context.Background(), apiKey))
+	return token
+}
+
+func newConsoleSystemSession(t *testing.T, repository domain.Repository) string {
+	account, _, err := repository.GetOrCreateAccount(context.Background(), domain.DefaultAuthProvider, "admin", "admin@example.com")
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(consoleSessionDuration)
+	token, apiKey := domain.CreateSystemApiKey(account, &expiresAt)
+	require.NoError(t, repository.SaveSystemApiKey(context.Background(), apiKey))
+	return token
+}
+
+func TestConsole_Middleware(t *testing.T) {
+	repository := newTestRepository(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	user := newTestUser(t, repository, tailnet, "john@example.com")
+	tailnet.IAMPolicy = domain.NewHuJSON(&domain.IAMPolicy{Roles: map[string]domain.UserRole{user.Name: domain.UserRoleAdmin}})
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+
+	e := newConsoleTestServer(t, repository)
+	path := fmt.Sprintf("/console/tailnets/%d/machines", tailnet.ID)
+
+	rec := consoleRequest(e, http.MethodGet, path, "", nil)
+	require.Equal(t, http.StatusFound, rec.Code)
+	require.Equal(t, "/console/login", rec.Header().Get("Location"))
+
+	rec = consoleRequest(e, http.MethodGet, path, "invalid_session", nil)
+	require.Equal(t, http.StatusFound, rec.Code)
+	require.Equal(t, "/console/login", rec.Header().Get("Location"))
+
+	rec = consoleRequest(e, http.MethodGet, path, newConsoleSession(t, repository, tailnet, user), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// suspended users lose access to the console
+	user.Suspended = true
+	require.NoError(t, repository.SaveUser(context.Background(), user))
+
+	rec = consoleRequest(e, http.MethodGet, path, newConsoleSession(t, repository, tailnet, user), nil)
+	require.Equal(t, http.StatusFound, rec.Code)
+	require.Equal(t, "/console/login", rec.Header().Get("Location"))
+}
+
+func TestConsole_Logout(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	user := newTestUser(t, repository, tailnet, "john@example.com")
+
+	e := newConsoleTestServer(t, repository)
+
+	for name, session := range map[string]string{
+		"api key":        newConsoleSession(t, repository, tailnet, user),
+		"system api key": newConsoleSystemSession(t, repository),
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := consoleRequest(e, http.MethodPost, "/console/logout", session, nil)
+			require.Equal(t, http.StatusFound, rec.Code)
+			require.Equal(t, "/", rec.Header().Get("Location"))
+			require.Contains(t, rec.Header().Values("Set-Cookie"), consoleSessionCookie+"=; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; HttpOnly; Secure; SameSite=Lax")
+
+			// the key of the session is revoked, so a copied cookie can't be used afterwards
+			apiKey, err := repository.LoadApiKey(ctx, session)
+			require.NoError(t, err)
+			require.Nil(t, apiKey)
+
+			systemApiKey, err := repository.LoadSystemApiKey(ctx, session)
+			require.NoError(t, err)
+			require.Nil(t, systemApiKey)
+
+			rec = consoleRequest(e, http.MethodGet, fmt.Sprintf("/console/tailnets/%d/machines", tailnet.ID), session, nil)
+			require.Equal(t, http.StatusFound, rec.Code)
+			require.Equal(t, "/console/login", rec.Header().Get("Location"))
+		})
+	}
+}
+
+func TestConsole_AuthorizeMachine(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	other := newTestTailnet(t, repository, "other")
+
+	admin := newTestUser(t, repository, tailnet, "admin@example.com")
+	tailnet.IAMPolicy = domain.NewHuJSON(&domain.IAMPolicy{Roles: map[string]domain.UserRole{admin.Name: domain.UserRoleAdmin}})
+	require.NoError(t, repository.SaveTailnet(ctx, tailnet))
+
+	member := newTestUser(t, repository, tailnet, "john@example.com")
+
+	m := newTestMachine(t, repository, tailnet, member)
+	m.Authorized = false
+	require.NoError(t, repository.SaveMachine(ctx, m))
+
+	otherMachine := newTestMachine(t, repository, other, newTestUser(t, repository, other, "jane@example.com"))
+	otherMachine.Authorized = false
+	require.NoError(t, repository.SaveMachine(ctx, otherMachine))
+
+	e := newConsoleTestServer(t, repository)
+
+	isAuthorized := func(id uint64) bool {
+		m, err := repository.GetMachine(ctx, id)
+		require.NoError(t, err)
+		return m.Authorized
+	}
+
+	// members can't authorize machines
+	rec := consoleRequest(e, http.MethodPost, fmt.Sprintf("/console/tailnets/%d/machines/%d/authorize", tailnet.ID, m.ID), newConsoleSession(t, repository, tailnet, member), nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.False(t, isAuthorized(m.ID))
+
+	// a machine of another tailnet can't be changed through the url of this tailnet
+	systemSession := newConsoleSystemSession(t, repository)
+	rec = consoleRequest(e, http.MethodPost, fmt.Sprintf("/console/tailnets/%d/machines/%d/authorize", tailnet.ID, otherMachine.ID), systemSession, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.False(t, isAuthorized(otherMachine.ID))
+
+	rec = consoleRequest(e, http.MethodPost, fmt.Sprintf("/console/tailnets/%d/machines/%d/routes", tailnet.ID, otherMachine.ID), systemSession, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = consoleRequest(e, http.MethodPost, fmt.Sprintf("/console/tailnets/%d/machines/%d/authorize", tailnet.ID, m.ID), newConsoleSession(t, repository, tailnet, admin), nil)
+	require.Equal(t, http.StatusFound, rec.Code)
+	require.Equal(t, fmt.Sprintf("/console/tailnets/%d/machines/%d", tailnet.ID, m.ID), rec.Header().Get("Location"))
+	require.True(t, isAuthorized(m.ID))
+}
@@ -1,28 +1,71 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/domain"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"net/http"
 	"tailscale.com/derp"
 	"tailscale.com/derp/derphttp"
+	"tailscale.com/net/netmon"
 	"tailscale.com/types/key"
 )
 
-func NewDERPHandler() *DERPHandlers {
+func NewDERPHandler(c *config.Config, repository domain.Repository, trusted ...key.NodePublic) (*DERPHandlers, error) {
 	logger := zap.L().Named("derp")
-	return &DERPHandlers{
-		s: derp.NewServer(key.NewNode(), func(format string, args ...any) {
-			logger.Debug(fmt.Sprintf(format, args...))
-		}),
+	s := derp.NewServer(key.NewNode(), func(format string, args ...any) {
+		logger.Debug(fmt.Sprintf(format, args...))
+	})
+
+	if c.DERP.Server.MeshKey != "" {
+		s.SetMeshKey(c.DERP.Server.MeshKey)
+	}
+
+	for _, peer := range c.DERP.Server.MeshPeers {
+		if err := startMesh(s, peer); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.DERP.Server.VerifyClients {
+		url, err := newDERPClientVerifier(repository, trusted...).listen()
+		if err != nil {
+			return nil, err
+		}
+		s.SetVerifyClientURL(url)
 	}
+
+	return &DERPHandlers{s: s}, nil
 }
 
 type DERPHandlers struct {
 	s *derp.Server
 }
 
+// startMesh connects to the DERP server of a mesh peer, and forwards the packets for the clients connected to that peer
+func startMesh(s *derp.Server, peer config.DERPMeshPeer) error {
+	logger := zap.L().Named("derp").With(zap.String("mesh_peer", peer.HostName()))
+	logf := func(format string, args ...any) {
+		logger.Debug(fmt.Sprintf(format, args...))
+	}
+
+	c, err := derphttp.NewClient(s.PrivateKey(), peer.URL(), logf, netmon.NewStatic())
+	if err != nil {
+		return err
+	}
+	c.MeshKey = s.MeshKey()
+	c.WatchConnectionChanges = true
+
+	add := func(m derp.PeerPresentMessage) { s.AddPacketForwarder(m.Key, c) }
+	remove := func(m derp.PeerGoneMessage) { s.RemovePacketForwarder(m.Peer, c) }
+	go c.RunWatchConnectionLoop(context.Background(), s.PublicKey(), logf, add, remove)
+
+	return nil
+}
+
 func (h *DERPHandlers) Handler(c echo.Context) error {
 	derphttp.Handler(h.s).ServeHTTP(c.Response(), c.Request())
 	return nil
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/derp"
+	"tailscale.com/derp/derphttp"
+	"tailscale.com/net/netmon"
+	"tailscale.com/types/key"
+	"tailscale.com/types/logger"
+)
+
+func TestDERPHandler_Mesh(t *testing.T) {
+	serverA := httptest.NewUnstartedServer(nil)
+	serverB := httptest.NewUnstartedServer(nil)
+
+	// both instances run on the loopback interface, but need a different hostname in the DERP map
+	publicAddr := func(s *httptest.Server) string {
+		if s == serverA {
+			return "localhost:443"
+		}
+		return "127.0.0.1:443"
+	}
+
+	newHandler := func(self, peer *httptest.Server) *DERPHandlers {
+		c := &config.Config{
+			PublicAddr:     publicAddr(self),
+			StunPublicAddr: publicAddr(self),
+			Auth:           config.Auth{ApiKeys: config.ApiKeys{DefaultExpiry: config.Duration(time.Hour), MaxExpiry: config.Duration(time.Hour)}},
+			DERP: config.DERP{Server: config.DERPServer{
+				MeshKey: "secret",
+				MeshPeers: []config.DERPMeshPeer{
+					{PublicAddr: publicAddr(peer), MeshURL: "http://" + peer.Listener.Addr().String() + "/derp"},
+				},
+			}},
+		}
+		c, err := c.Validate()
+		require.NoError(t, err)
+
+		h, err := NewDERPHandler(c, nil)
+		require.NoError(t, err)
+		return h
+	}
+
+	serverA.Config.Handler = derphttp.Handler(newHandler(serverA, serverB).s)
+	serverB.Config.Handler = derphttp.Handler(newHandler(serverB, serverA).s)
+	serverA.Start()
+	serverB.Start()
+	defer serverA.Close()
+	defer serverB.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	newClient := func(server *httptest.Server) (*derphttp.Client, key.NodePublic) {
+		privateKey := key.NewNode()
+		c, err := derphttp.NewClient(privateKey, server.URL+"/derp", logger.Discard, netmon.NewStatic())
+		require.NoError(t, err)
+		require.NoError(t, c.Connect(ctx))
+		t.Cleanup(func() { _ = c.Close() })
+		return c, privateKey.Public()
+	}
+
+	sender, senderKey := newClient(serverA)
+	receiver, receiverKey := newClient(serverB)
+
+	received := make(chan derp.ReceivedPacket, 1)
+	go func() {
+		for {
+			m, err := receiver.Recv()
+			if err != nil {
+				return
+			}
+			if p, ok := m.(derp.ReceivedPacket); ok {
+				received <- p
+				return
+			}
+		}
+	}()
+
+	// packets are only forwarded once the mesh connection announced the receiver to the server of the sender
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		require.NoError(t, sender.Send(receiverKey, []byte("hello")))
+
+		select {
+		case p := <-received:
+			require.Equal(t, senderKey, p.Source)
+			require.Equal(t, []byte("hello"), p.Data)
+			return
+		case <-ticker.C:
+		case <-ctx.Done():
+			t.Fatal("packet not forwarded over the mesh")
+		}
+	}
+}
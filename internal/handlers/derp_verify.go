@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"go.uber.org/zap"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+	"tailscale.com/util/lru"
+	"time"
+)
+
+const (
+	derpAdmittedClientTTL = 1 * time.Minute
+	derpRejectedClientTTL = 10 * time.Second
+	derpVerifierCacheSize = 10000
+)
+
+type derpAdmission struct {
+	reason    string
+	expiresAt time.Time
+}
+
+func (a derpAdmission) allowed() bool {
+	return a.reason == ""
+}
+
+// derpClientVerifier decides if a node is allowed to connect to the embedded DERP server,
+// only known, authorized and not expired machines are admitted. Decisions are cached for a short period,
+// as clients reconnect frequently.
+type derpClientVerifier struct {
+	repository domain.Repository
+	trusted    []key.NodePublic
+	now        func() time.Time
+
+	lock  sync.Mutex
+	cache *lru.Cache[key.NodePublic, derpAdmission]
+}
+
+func newDERPClientVerifier(repository domain.Repository, trusted ...key.NodePublic) *derpClientVerifier {
+	return &derpClientVerifier{
+		repository: repository,
+		trusted:    trusted,
+		now:        time.Now,
+		cache:      &lru.Cache[key.NodePublic, derpAdmission]{MaxEntries: derpVerifierCacheSize},
+	}
+}
+
+// listen serves the admission requests of the DERP server on a loopback address, and returns its url.
+// The DERP server only supports verifying clients with an admission controller, which is served by ionscale itself.
+func (v *derpClientVerifier) listen() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /derp/admit", v.admit)
+
+	go func() {
+		if err := http.Serve(l, mux); err != nil {
+			zap.L().Named("derp").Error("derp admission controller stopped", zap.Error(err))
+		}
+	}()
+
+	return fmt.Sprintf("http://%s/derp/admit", l.Addr().String()), nil
+}
+
+func (v *derpClientVerifier) admit(w http.ResponseWriter, r *http.Request) {
+	var req tailcfg.DERPAdmitClientRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4<<10)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	allowed, err := v.verify(r.Context(), req.NodePublic)
+	if err != nil {
+		derpRejectedClients.WithLabelValues("error").Inc()
+		zap.L().Named("derp").Error("unable to verify derp client", zap.String("node_key", req.NodePublic.String()), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&tailcfg.DERPAdmitClientResponse{Allow: allowed})
+}
+
+func (v *derpClientVerifier) verify(ctx context.Context, nodeKey key.NodePublic) (bool, error) {
+	if slices.Contains(v.trusted, nodeKey) {
+		return true, nil
+	}
+
+	now := v.now()
+
+	v.lock.Lock()
+	a, ok := v.cache.GetOk(nodeKey)
+	v.lock.Unlock()
+
+	if !ok || now.After(a.expiresAt) {
+		m, err := v.repository.GetMachineByNodeKey(ctx, nodeKey.String())
+		if err != nil {
+			return false, err
+		}
+
+		a = derpAdmission{expiresAt: now.Add(derpAdmittedClientTTL)}
+		switch {
+		case m == nil:
+			a.reason = "unknown"
+		case !m.Authorized:
+			a.reason = "unauthorized"
+		case m.IsExpired():
+			a.reason = "expired"
+		}
+
+		if !a.allowed() {
+			a.expiresAt = now.Add(derpRejectedClientTTL)
+		}
+
+		v.lock.Lock()
+		v.cache.Set(nodeKey, a)
+		v.lock.Unlock()
+	}
+
+	if !a.allowed() {
+		derpRejectedClients.WithLabelValues(a.reason).Inc()
+	}
+
+	return a.allowed(), nil
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+)
+
+func TestDERPClientVerifier(t *testing.T) {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	tailnet := &domain.Tailnet{ID: util.NextID(), Name: "tailnet"}
+	require.NoError(t, repository.SaveTailnet(ctx, tailnet))
+
+	user := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(ctx, user))
+
+	newMachine := func(name string, authorized bool, expiresAt time.Time) (*domain.Machine, key.NodePublic) {
+		nodeKey := key.NewNode().Public()
+		ipv4, ipv6 := netip.MustParseAddr("100.64.0.1"), netip.MustParseAddr("fd7a:115c:a1e0::1")
+		m := &domain.Machine{
+			ID:         util.NextID(),
+			Name:       name,
+			NodeKey:    nodeKey.String(),
+			TailnetID:  tailnet.ID,
+			UserID:     user.ID,
+			Authorized: authorized,
+			IPv4:       domain.IP{Addr: &ipv4},
+			IPv6:       domain.IP{Addr: &ipv6},
+			ExpiresAt:  expiresAt,
+		}
+		require.NoError(t, repository.SaveMachine(ctx, m))
+		return m, nodeKey
+	}
+
+	var elapsed atomic.Int64
+	now := time.Now()
+
+	trusted := key.NewNode().Public()
+	v := newDERPClientVerifier(repository, trusted)
+	v.now = func() time.Time { return now.Add(time.Duration(elapsed.Load())) }
+
+	url, err := v.listen()
+	require.NoError(t, err)
+
+	admit := func(nodeKey key.NodePublic) bool {
+		body, err := json.Marshal(&tailcfg.DERPAdmitClientRequest{NodePublic: nodeKey, Source: netip.MustParseAddr("192.0.2.1")})
+		require.NoError(t, err)
+
+		resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var r tailcfg.DERPAdmitClientResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+		return r.Allow
+	}
+
+	m, authorized := newMachine("authorized", true, now.Add(time.Hour))
+	_, unauthorized := newMachine("unauthorized", false, now.Add(time.Hour))
+	_, expired := newMachine("expired", true, now.Add(-time.Hour))
+
+	require.True(t, admit(authorized))
+	require.False(t, admit(unauthorized))
+	require.False(t, admit(expired))
+	require.False(t, admit(key.NewNode().Public()))
+	require.True(t, admit(trusted))
+
+	_, err = repository.DeleteMachine(ctx, m.ID)
+	require.NoError(t, err)
+	require.True(t, admit(authorized), "admission is cached")
+
+	elapsed.Store(int64(derpAdmittedClientTTL + time.Second))
+	require.False(t, admit(authorized))
+}
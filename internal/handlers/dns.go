@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/dns"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
 	"github.com/labstack/echo/v4"
 	"net"
 	"net/http"
@@ -11,14 +15,20 @@ import (
 	"time"
 )
 
-func NewDNSHandlers(_ key.MachinePublic, provider dns.Provider) *DNSHandlers {
+func NewDNSHandlers(machineKey key.MachinePublic, config *config.Config, provider dns.Provider, repository domain.Repository) *DNSHandlers {
 	return &DNSHandlers{
-		provider: provider,
+		machineKey: machineKey,
+		recordTTL:  config.DNS.RecordTTL(),
+		provider:   provider,
+		repository: repository,
 	}
 }
 
 type DNSHandlers struct {
-	provider dns.Provider
+	machineKey key.MachinePublic
+	recordTTL  time.Duration
+	provider   dns.Provider
+	repository domain.Repository
 }
 
 func (h *DNSHandlers) SetDNS(c echo.Context) error {
@@ -37,10 +47,40 @@ func (h *DNSHandlers) SetDNS(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound)
 	}
 
+	machine, err := h.repository.GetMachineByKeys(ctx, h.machineKey.String(), req.NodeKey.String())
+	if err != nil {
+		return logError(err)
+	}
+
+	if machine == nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	// a machine only sets the records for the certificates of its own name
+	name := fmt.Sprintf("_acme-challenge.%s.%s", machine.CompleteName(), machine.Tailnet.DNSDomain(config.MagicDNSSuffix()))
+	if !strings.EqualFold(strings.TrimSuffix(req.Name, "."), name) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+
 	if err := h.provider.SetRecord(ctx, req.Type, req.Name, req.Value); err != nil {
 		return logError(err)
 	}
 
+	now := time.Now().UTC()
+	record := &domain.ManagedDNSRecord{
+		ID:        util.NextID(),
+		Type:      req.Type,
+		Name:      req.Name,
+		Value:     req.Value,
+		CreatedAt: now,
+		ExpiresAt: now.Add(h.recordTTL),
+		MachineID: machine.ID,
+	}
+
+	if err := h.repository.SetManagedDNSRecord(ctx, record); err != nil {
+		return logError(err)
+	}
+
 	if strings.HasPrefix(req.Name, "_acme-challenge") && req.Type == "TXT" {
 		// Listen to connection close
 		notify := ctx.Done()
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+)
+
+type recordingDNSProvider struct {
+	records []string
+}
+
+func (p *recordingDNSProvider) Zone() string {
+	return "example.net"
+}
+
+func (p *recordingDNSProvider) SetRecord(_ context.Context, _, name, _ string) error {
+	p.records = append(p.records, name)
+	return nil
+}
+
+func (p *recordingDNSProvider) DeleteRecord(context.Context, string, string, string) error {
+	return nil
+}
+
+func TestDNSHandlers_SetDNS_RecordName(t *testing.T) {
+	repository := newTestRepository(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	tailnet.DNSConfig.Domain = "corp.example.net"
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+
+	other := newTestTailnet(t, repository, "other")
+	other.DNSConfig.Domain = "other.example.net"
+	require.NoError(t, repository.SaveTailnet(context.Background(), other))
+
+	machineKey := key.NewMachine().Public()
+	nodeKey := key.NewNode().Public()
+
+	m := newTestMachine(t, repository, tailnet, newTestUser(t, repository, tailnet, "john@example.com"))
+	m.MachineKey = machineKey.String()
+	m.NodeKey = nodeKey.String()
+	require.NoError(t, repository.SaveMachine(context.Background(), m))
+
+	provider := &recordingDNSProvider{}
+	h := NewDNSHandlers(machineKey, &config.Config{}, provider, repository)
+
+	setDNS := func(name string) int {
+		body, err := json.Marshal(&tailcfg.SetDNSRequest{Version: SupportedCapabilityVersion, NodeKey: nodeKey, Name: name, Type: "TXT", Value: "challenge"})
+		require.NoError(t, err)
+
+		// the handler waits for the record to be resolvable, until the request is done
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		req := httptest.NewRequest(http.MethodPost, "/machine/set-dns", strings.NewReader(string(body))).WithContext(ctx)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		err = h.SetDNS(echo.New().NewContext(req, rec))
+		if he, ok := err.(*echo.HTTPError); ok {
+			return he.Code
+		}
+		require.NoError(t, err)
+		return http.StatusOK
+	}
+
+	require.Equal(t, http.StatusForbidden, setDNS("_acme-challenge.laptop.other.example.net"))
+	require.Equal(t, http.StatusForbidden, setDNS("_acme-challenge."+m.Name+".other.example.net"))
+	require.Equal(t, http.StatusForbidden, setDNS("_acme-challenge.corp.example.net"))
+	require.Equal(t, http.StatusForbidden, setDNS("www."+m.Name+".corp.example.net"))
+	require.Empty(t, provider.records)
+
+	require.Equal(t, http.StatusOK, setDNS("_acme-challenge."+m.Name+".corp.example.net"))
+	require.Equal(t, []string{"_acme-challenge." + m.Name + ".corp.example.net"}, provider.records)
+}
@@ -148,12 +148,10 @@ func (h *OIDCConfigHandlers) Jwks(c echo.Context) error {
 	return c.JSON(http.StatusOK, set)
 }
 
+// names returns the name of the machine, the domain of the tailnet and the fully qualified name of the machine,
+// the domain is the same as the MagicDNS domain, with or without a custom domain configured.
 func (h *IDTokenHandlers) names(m *domain.Machine) (string, string, string) {
-	var name = m.Name
-	if m.NameIdx != 0 {
-		name = fmt.Sprintf("%s-%d", m.Name, m.NameIdx)
-	}
-
-	sanitizedTailnetName := domain.SanitizeTailnetName(m.Tailnet.Name)
-	return name, sanitizedTailnetName, fmt.Sprintf("%s.%s", name, sanitizedTailnetName)
+	name := m.CompleteName()
+	tailnetDomain := m.Tailnet.DNSDomain(config.MagicDNSSuffix())
+	return name, tailnetDomain, fmt.Sprintf("%s.%s", name, tailnetDomain)
 }
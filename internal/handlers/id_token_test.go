@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDTokenHandlers_Names(t *testing.T) {
+	h := &IDTokenHandlers{}
+
+	m := &domain.Machine{Name: "laptop", NameIdx: 1, Tailnet: domain.Tailnet{Name: "Acme Inc"}}
+
+	name, tailnetDomain, fqdn := h.names(m)
+	require.Equal(t, "laptop-1", name)
+	require.Equal(t, "acme-inc."+config.MagicDNSSuffix(), tailnetDomain)
+	require.Equal(t, "laptop-1.acme-inc."+config.MagicDNSSuffix(), fqdn)
+
+	// with a custom domain, the claims use the same domain as MagicDNS
+	m.Tailnet.DNSConfig.Domain = "corp.example.net"
+
+	name, tailnetDomain, fqdn = h.names(m)
+	require.Equal(t, "laptop-1", name)
+	require.Equal(t, "corp.example.net", tailnetDomain)
+	require.Equal(t, "laptop-1.corp.example.net", fqdn)
+}
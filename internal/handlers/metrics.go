@@ -13,4 +13,10 @@ var (
 		Name:      "connected_machines_total",
 		Help:      "Total amount of connected machines",
 	}, []string{"tailnet"})
+
+	derpRejectedClients = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: prometheusNamespace,
+		Name:      "derp_rejected_clients_total",
+		Help:      "Total amount of clients rejected by the embedded DERP server",
+	}, []string{"reason"})
 )
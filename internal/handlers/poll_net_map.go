@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/derp"
 	"github.com/jsiebens/ionscale/internal/domain"
 	"github.com/jsiebens/ionscale/internal/mapping"
 	"github.com/klauspost/compress/zstd"
@@ -23,12 +24,14 @@ import (
 func NewPollNetMapHandler(
 	machineKey key.MachinePublic,
 	sessionManager core.PollMapSessionManager,
-	repository domain.Repository) *PollNetMapHandler {
+	repository domain.Repository,
+	derpProber *derp.Prober) *PollNetMapHandler {
 
 	handler := &PollNetMapHandler{
 		machineKey:     machineKey,
 		sessionManager: sessionManager,
 		repository:     repository,
+		derpProber:     derpProber,
 	}
 
 	return handler
@@ -38,6 +41,7 @@ type PollNetMapHandler struct {
 	machineKey     key.MachinePublic
 	repository     domain.Repository
 	sessionManager core.PollMapSessionManager
+	derpProber     *derp.Prober
 }
 
 func (h *PollNetMapHandler) PollNetMap(c echo.Context) error {
@@ -75,7 +79,7 @@ func (h *PollNetMapHandler) handlePollNetMap(c echo.Context, m *domain.Machine,
 	tailnetID := m.TailnetID
 	machineID := m.ID
 
-	mapper := mapping.NewPollNetMapper(mapRequest, m.ID, h.repository, h.sessionManager)
+	mapper := mapping.NewPollNetMapper(mapRequest, m.ID, h.repository, h.sessionManager, h.derpProber)
 
 	response, err := h.createMapResponse(mapper, false, mapRequest.Compress)
 	if err != nil {
@@ -84,7 +88,11 @@ func (h *PollNetMapHandler) handlePollNetMap(c echo.Context, m *domain.Machine,
 
 	if !mapRequest.Stream {
 		if !slices.Equal(m.HostInfo.RoutableIPs, mapRequest.Hostinfo.RoutableIPs) {
-			m.AutoAllowIPs = m.Tailnet.ACLPolicy.Get().FindAutoApprovedIPs(mapRequest.Hostinfo.RoutableIPs, m.Tags, &m.User)
+			policy, err := h.repository.GetACLPolicy(ctx, &m.Tailnet)
+			if err != nil {
+				return logError(err)
+			}
+			m.AutoAllowIPs = policy.FindAutoApprovedIPs(mapRequest.Hostinfo.RoutableIPs, m.Tags, &m.User)
 		}
 
 		m.HostInfo = domain.HostInfo(*mapRequest.Hostinfo)
@@ -108,6 +116,16 @@ func (h *PollNetMapHandler) handlePollNetMap(c echo.Context, m *domain.Machine,
 
 		h.sessionManager.NotifyAll(tailnetID)
 
+		// peers in other tailnets, connected by shared machines, need the updated endpoints as well
+		sharingTailnetIDs, err := h.repository.ListSharingTailnetIDs(ctx, tailnetID)
+		if err != nil {
+			return logError(err)
+		}
+
+		for _, id := range sharingTailnetIDs {
+			h.sessionManager.NotifyAll(id)
+		}
+
 		return c.JSONBlob(http.StatusOK, response)
 	}
 
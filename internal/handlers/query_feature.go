@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fmt"
+	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/dns"
 	"github.com/jsiebens/ionscale/internal/domain"
 	"github.com/labstack/echo/v4"
@@ -51,6 +52,13 @@ func (h *QueryFeatureHandlers) QueryFeature(c echo.Context) error {
 		if h.dnsProvider == nil || !machine.Tailnet.DNSConfig.HttpsCertsEnabled {
 			resp.Text = fmt.Sprintf(serverMessage, machine.Tailnet.Name)
 			resp.Complete = false
+			break
+		}
+
+		tailnetDomain := machine.Tailnet.DNSDomain(config.MagicDNSSuffix())
+		if !domain.IsSubdomain(tailnetDomain, h.dnsProvider.Zone()) {
+			resp.Text = fmt.Sprintf(serveZoneMessage, tailnetDomain, h.dnsProvider.Zone())
+			resp.Complete = false
 		}
 	case "funnel":
 		resp.Text = fmt.Sprintf("Sorry, ionscale has no support for feature '%s'\n", req.Feature)
@@ -63,6 +71,11 @@ func (h *QueryFeatureHandlers) QueryFeature(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+const serveZoneMessage = `HTTPS certificates are not available for this tailnet:
+
+  the DNS domain [%s] is not part of the zone [%s] of the DNS provider
+`
+
 const serverMessage = `Enabling HTTPS is required to use Serve:
 
   ionscale tailnets set-dns --tailnet %s --https-certs=true --magic-dns
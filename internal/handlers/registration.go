@@ -70,10 +70,17 @@ func (h *RegistrationHandlers) Register(c echo.Context) error {
 			m.ExpiresAt = req.Expiry
 
 			if m.Ephemeral {
+				sharingTailnetIDs, err := h.repository.ListSharingTailnetIDs(ctx, m.TailnetID)
+				if err != nil {
+					return logError(err)
+				}
 				if _, err := h.repository.DeleteMachine(ctx, m.ID); err != nil {
 					return logError(err)
 				}
 				h.sessionManager.NotifyAll(m.TailnetID)
+				for _, id := range sharingTailnetIDs {
+					h.sessionManager.NotifyAll(id)
+				}
 			} else {
 				if err := h.repository.SaveMachine(ctx, m); err != nil {
 					return logError(err)
@@ -161,10 +168,20 @@ func (h *RegistrationHandlers) authenticateMachineWithAuthKey(c echo.Context, ma
 		return c.JSON(http.StatusOK, response)
 	}
 
+	if authKey.User.Suspended {
+		response := tailcfg.RegisterResponse{MachineAuthorized: false, Error: "user is suspended"}
+		return c.JSON(http.StatusOK, response)
+	}
+
 	tailnet := authKey.Tailnet
 	user := authKey.User
 
-	if err := tailnet.ACLPolicy.Get().CheckTagOwners(req.Hostinfo.RequestTags, &user); err != nil {
+	policy, err := h.repository.GetACLPolicy(ctx, &tailnet)
+	if err != nil {
+		return logError(err)
+	}
+
+	if err := policy.CheckTagOwners(req.Hostinfo.RequestTags, &user); err != nil {
 		response := tailcfg.RegisterResponse{MachineAuthorized: false, Error: err.Error()}
 		return c.JSON(http.StatusOK, response)
 	}
@@ -173,7 +190,7 @@ func (h *RegistrationHandlers) authenticateMachineWithAuthKey(c echo.Context, ma
 	advertisedTags := domain.SanitizeTags(req.Hostinfo.RequestTags)
 	tags := append(registeredTags, advertisedTags...)
 
-	autoAllowIPs := tailnet.ACLPolicy.Get().FindAutoApprovedIPs(req.Hostinfo.RoutableIPs, tags, &user)
+	autoAllowIPs := policy.FindAutoApprovedIPs(req.Hostinfo.RoutableIPs, tags, &user)
 
 	var m *domain.Machine
 
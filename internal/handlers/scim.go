@@ -0,0 +1,875 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	scimSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSchemaSPConfig     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	scimContentType = "application/scim+json"
+	scimTailnetKey  = "scim_tailnet"
+)
+
+var (
+	scimFilterRegex        = regexp.MustCompile(`^\s*(\w+)\s+eq\s+"([^"]*)"\s*$`)
+	scimMemberFilterRegex  = regexp.MustCompile(`^members\[value eq "([^"]+)"]$`)
+	errSCIMInvalidSyntax   = scimError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	errSCIMUserNotFound    = scimError(http.StatusNotFound, "", "user not found")
+	errSCIMGroupNotFound   = scimError(http.StatusNotFound, "", "group not found")
+	errSCIMUnauthorized    = scimError(http.StatusUnauthorized, "", "invalid token")
+	errSCIMInvalidFilter   = scimError(http.StatusBadRequest, "invalidFilter", "unsupported filter")
+	errSCIMUserNameMissing = scimError(http.StatusBadRequest, "invalidValue", "userName is required")
+)
+
+func NewSCIMHandlers(repository domain.Repository, sessionManager core.PollMapSessionManager) *SCIMHandlers {
+	return &SCIMHandlers{
+		repository:     repository,
+		sessionManager: sessionManager,
+	}
+}
+
+// SCIMHandlers implements a subset of the SCIM 2.0 protocol (RFC 7643, RFC 7644)
+// so identity providers can provision users and groups into a tailnet.
+// The tailnet is identified by the bearer token used by the client.
+type SCIMHandlers struct {
+	repository     domain.Repository
+	sessionManager core.PollMapSessionManager
+}
+
+type scimName struct {
+	Formatted string `json:"formatted,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Name        *scimName   `json:"name,omitempty"`
+	Emails      []scimEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members,omitempty"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+type scimHTTPError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimHTTPError) Error() string {
+	return e.detail
+}
+
+func scimError(status int, scimType, detail string) *scimHTTPError {
+	return &scimHTTPError{status: status, scimType: scimType, detail: detail}
+}
+
+// Middleware authenticates the SCIM client and renders errors as SCIM error responses.
+func (h *SCIMHandlers) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := h.authenticate(c, next)
+
+		if e, ok := err.(*scimHTTPError); ok {
+			return h.render(c, e.status, &scimErrorResponse{
+				Schemas:  []string{scimSchemaError},
+				Status:   strconv.Itoa(e.status),
+				ScimType: e.scimType,
+				Detail:   e.detail,
+			})
+		}
+
+		return err
+	}
+}
+
+func (h *SCIMHandlers) authenticate(c echo.Context, next echo.HandlerFunc) error {
+	authorizationHeader := c.Request().Header.Get("Authorization")
+	bearerToken := strings.TrimPrefix(authorizationHeader, "Bearer ")
+
+	token, err := h.repository.LoadSCIMToken(c.Request().Context(), bearerToken)
+	if err != nil {
+		return logError(err)
+	}
+
+	if token == nil {
+		return errSCIMUnauthorized
+	}
+
+	c.Set(scimTailnetKey, &token.Tailnet)
+
+	return next(c)
+}
+
+func (h *SCIMHandlers) ServiceProviderConfig(c echo.Context) error {
+	supported := func(v bool) map[string]interface{} {
+		return map[string]interface{}{"supported": v}
+	}
+
+	return h.render(c, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{scimSchemaSPConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": 1000},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{
+			{"type": "oauthbearertoken", "name": "OAuth Bearer Token", "description": "Authentication with a SCIM token generated by ionscale"},
+		},
+	})
+}
+
+func (h *SCIMHandlers) ListUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	tailnet := h.tailnet(c)
+
+	attr, value, err := h.parseFilter(c.QueryParam("filter"))
+	if err != nil {
+		return err
+	}
+
+	users, err := h.repository.ListUsers(ctx, tailnet.ID)
+	if err != nil {
+		return logError(err)
+	}
+
+	var resources []interface{}
+	for _, u := range users {
+		switch strings.ToLower(attr) {
+		case "":
+		case "username":
+			if !strings.EqualFold(u.Name, value) {
+				continue
+			}
+		case "externalid":
+			if u.ExternalID != value {
+				continue
+			}
+		default:
+			return errSCIMInvalidFilter
+		}
+		resources = append(resources, h.toSCIMUser(c, &u))
+	}
+
+	return h.renderList(c, resources)
+}
+
+func (h *SCIMHandlers) GetUser(c echo.Context) error {
+	user, err := h.findUser(c)
+	if err != nil {
+		return err
+	}
+
+	return h.render(c, http.StatusOK, h.toSCIMUser(c, user))
+}
+
+func (h *SCIMHandlers) CreateUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	tailnet := h.tailnet(c)
+
+	var input scimUser
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	if input.UserName == "" {
+		return errSCIMUserNameMissing
+	}
+
+	existing, err := h.findUserByName(ctx, tailnet.ID, input.UserName)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return scimError(http.StatusConflict, "uniqueness", fmt.Sprintf("user '%s' already exists", input.UserName))
+	}
+
+	user := &domain.User{
+		ID:         util.NextID(),
+		Name:       input.UserName,
+		UserType:   domain.UserTypePerson,
+		ExternalID: input.ExternalID,
+		Suspended:  input.Active != nil && !*input.Active,
+		TailnetID:  tailnet.ID,
+	}
+
+	if err := h.repository.SaveUser(ctx, user); err != nil {
+		return logError(err)
+	}
+
+	return h.render(c, http.StatusCreated, h.toSCIMUser(c, user))
+}
+
+func (h *SCIMHandlers) ReplaceUser(c echo.Context) error {
+	user, err := h.findUser(c)
+	if err != nil {
+		return err
+	}
+
+	var input scimUser
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	if input.UserName == "" {
+		return errSCIMUserNameMissing
+	}
+
+	user.ExternalID = input.ExternalID
+
+	if err := h.updateUser(c.Request().Context(), user, input.UserName, input.Active == nil || *input.Active); err != nil {
+		return err
+	}
+
+	return h.render(c, http.StatusOK, h.toSCIMUser(c, user))
+}
+
+func (h *SCIMHandlers) PatchUser(c echo.Context) error {
+	user, err := h.findUser(c)
+	if err != nil {
+		return err
+	}
+
+	var input scimPatchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	userName := user.Name
+	active := !user.Suspended
+
+	for _, op := range input.Operations {
+		if !strings.EqualFold(op.Op, "replace") && !strings.EqualFold(op.Op, "add") {
+			return scimError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("unsupported operation '%s'", op.Op))
+		}
+
+		values := map[string]json.RawMessage{}
+		if op.Path != "" {
+			values[op.Path] = op.Value
+		} else if err := json.Unmarshal(op.Value, &values); err != nil {
+			return errSCIMInvalidSyntax
+		}
+
+		for path, value := range values {
+			switch strings.ToLower(path) {
+			case "active":
+				v, err := parseSCIMBool(value)
+				if err != nil {
+					return errSCIMInvalidSyntax
+				}
+				active = v
+			case "username":
+				if err := json.Unmarshal(value, &userName); err != nil {
+					return errSCIMInvalidSyntax
+				}
+			case "externalid":
+				if err := json.Unmarshal(value, &user.ExternalID); err != nil {
+					return errSCIMInvalidSyntax
+				}
+			}
+		}
+	}
+
+	if err := h.updateUser(c.Request().Context(), user, userName, active); err != nil {
+		return err
+	}
+
+	return h.render(c, http.StatusOK, h.toSCIMUser(c, user))
+}
+
+func (h *SCIMHandlers) DeleteUser(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, err := h.findUser(c)
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.repository.ListGroups(ctx, user.TailnetID)
+	if err != nil {
+		return logError(err)
+	}
+
+	err = h.repository.Transaction(func(tx domain.Repository) error {
+		if err := h.deprovisionUser(ctx, tx, user.ID); err != nil {
+			return err
+		}
+
+		for _, g := range groups {
+			if len(g.Members) != 0 {
+				g.Members.Remove(user.ID)
+				if err := tx.SaveGroup(ctx, &g); err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.DeleteUser(ctx, user.ID)
+	})
+	if err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(user.TailnetID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *SCIMHandlers) ListGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	tailnet := h.tailnet(c)
+
+	attr, value, err := h.parseFilter(c.QueryParam("filter"))
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.repository.ListGroups(ctx, tailnet.ID)
+	if err != nil {
+		return logError(err)
+	}
+
+	excludeMembers := strings.Contains(c.QueryParam("excludedAttributes"), "members")
+
+	var resources []interface{}
+	for _, g := range groups {
+		switch strings.ToLower(attr) {
+		case "":
+		case "displayname":
+			if !strings.EqualFold(g.Name, value) {
+				continue
+			}
+		case "externalid":
+			if g.ExternalID != value {
+				continue
+			}
+		default:
+			return errSCIMInvalidFilter
+		}
+
+		group := h.toSCIMGroup(c, &g)
+		if excludeMembers {
+			group.Members = nil
+		}
+		resources = append(resources, group)
+	}
+
+	return h.renderList(c, resources)
+}
+
+func (h *SCIMHandlers) GetGroup(c echo.Context) error {
+	group, err := h.findGroup(c)
+	if err != nil {
+		return err
+	}
+
+	return h.render(c, http.StatusOK, h.toSCIMGroup(c, group))
+}
+
+func (h *SCIMHandlers) CreateGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	tailnet := h.tailnet(c)
+
+	var input scimGroup
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	if input.DisplayName == "" {
+		return scimError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	group := &domain.Group{
+		ID:         util.NextID(),
+		Name:       input.DisplayName,
+		ExternalID: input.ExternalID,
+		Members:    domain.GroupMembers{},
+		TailnetID:  tailnet.ID,
+	}
+
+	members, err := h.parseMembers(ctx, tailnet.ID, input.Members)
+	if err != nil {
+		return err
+	}
+	group.Members.Add(members...)
+
+	if err := h.checkGroupAlias(ctx, group); err != nil {
+		return err
+	}
+
+	if err := h.repository.SaveGroup(ctx, group); err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(tailnet.ID)
+
+	return h.render(c, http.StatusCreated, h.toSCIMGroup(c, group))
+}
+
+func (h *SCIMHandlers) ReplaceGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	group, err := h.findGroup(c)
+	if err != nil {
+		return err
+	}
+
+	var input scimGroup
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	members, err := h.parseMembers(ctx, group.TailnetID, input.Members)
+	if err != nil {
+		return err
+	}
+
+	if input.DisplayName != "" {
+		group.Name = input.DisplayName
+	}
+	group.ExternalID = input.ExternalID
+	group.Members = domain.GroupMembers{}
+	group.Members.Add(members...)
+
+	if err := h.checkGroupAlias(ctx, group); err != nil {
+		return err
+	}
+
+	if err := h.repository.SaveGroup(ctx, group); err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(group.TailnetID)
+
+	return h.render(c, http.StatusOK, h.toSCIMGroup(c, group))
+}
+
+func (h *SCIMHandlers) PatchGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	group, err := h.findGroup(c)
+	if err != nil {
+		return err
+	}
+
+	var input scimPatchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&input); err != nil {
+		return errSCIMInvalidSyntax
+	}
+
+	for _, op := range input.Operations {
+		operation := strings.ToLower(op.Op)
+		path := op.Path
+
+		// e.g. remove with path members[value eq "123"]
+		if matches := scimMemberFilterRegex.FindStringSubmatch(path); matches != nil {
+			id, err := strconv.ParseUint(matches[1], 10, 64)
+			if err != nil {
+				return errSCIMInvalidSyntax
+			}
+			if operation != "remove" {
+				return scimError(http.StatusBadRequest, "invalidPath", fmt.Sprintf("unsupported path '%s'", path))
+			}
+			group.Members.Remove(id)
+			continue
+		}
+
+		values := map[string]json.RawMessage{}
+		if path != "" {
+			values[path] = op.Value
+		} else if err := json.Unmarshal(op.Value, &values); err != nil {
+			return errSCIMInvalidSyntax
+		}
+
+		for p, value := range values {
+			switch strings.ToLower(p) {
+			case "displayname":
+				if err := json.Unmarshal(value, &group.Name); err != nil {
+					return errSCIMInvalidSyntax
+				}
+			case "externalid":
+				if err := json.Unmarshal(value, &group.ExternalID); err != nil {
+					return errSCIMInvalidSyntax
+				}
+			case "members":
+				var members []scimMember
+				if len(value) != 0 {
+					if err := json.Unmarshal(value, &members); err != nil {
+						return errSCIMInvalidSyntax
+					}
+				}
+
+				ids, err := h.parseMembers(ctx, group.TailnetID, members)
+				if err != nil {
+					return err
+				}
+
+				switch operation {
+				case "add":
+					group.Members.Add(ids...)
+				case "remove":
+					if len(value) == 0 {
+						group.Members = domain.GroupMembers{}
+					} else {
+						group.Members.Remove(ids...)
+					}
+				case "replace":
+					group.Members = domain.GroupMembers{}
+					group.Members.Add(ids...)
+				default:
+					return scimError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("unsupported operation '%s'", op.Op))
+				}
+			}
+		}
+	}
+
+	if err := h.checkGroupAlias(ctx, group); err != nil {
+		return err
+	}
+
+	if err := h.repository.SaveGroup(ctx, group); err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(group.TailnetID)
+
+	return h.render(c, http.StatusOK, h.toSCIMGroup(c, group))
+}
+
+func (h *SCIMHandlers) DeleteGroup(c echo.Context) error {
+	group, err := h.findGroup(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.repository.DeleteGroup(c.Request().Context(), group.ID); err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(group.TailnetID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *SCIMHandlers) updateUser(ctx context.Context, user *domain.User, userName string, active bool) error {
+	if !strings.EqualFold(user.Name, userName) {
+		existing, err := h.findUserByName(ctx, user.TailnetID, userName)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return scimError(http.StatusConflict, "uniqueness", fmt.Sprintf("user '%s' already exists", userName))
+		}
+	}
+
+	deactivated := !user.Suspended && !active
+
+	user.Name = userName
+	user.Suspended = !active
+
+	err := h.repository.Transaction(func(tx domain.Repository) error {
+		if deactivated {
+			if err := h.deprovisionUser(ctx, tx, user.ID); err != nil {
+				return err
+			}
+		}
+		return tx.SaveUser(ctx, user)
+	})
+	if err != nil {
+		return logError(err)
+	}
+
+	h.sessionManager.NotifyAll(user.TailnetID)
+
+	return nil
+}
+
+// deprovisionUser removes all machines and keys of a user, so access to the tailnet is revoked immediately
+func (h *SCIMHandlers) deprovisionUser(ctx context.Context, tx domain.Repository, userID uint64) error {
+	if err := tx.DeleteMachineByUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := tx.DeleteApiKeysByUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := tx.DeleteAuthKeysByUser(ctx, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *SCIMHandlers) tailnet(c echo.Context) *domain.Tailnet {
+	return c.Get(scimTailnetKey).(*domain.Tailnet)
+}
+
+func (h *SCIMHandlers) findUser(c echo.Context) (*domain.User, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return nil, errSCIMUserNotFound
+	}
+
+	user, err := h.repository.GetUser(c.Request().Context(), id)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if user == nil || user.TailnetID != h.tailnet(c).ID || user.UserType != domain.UserTypePerson {
+		return nil, errSCIMUserNotFound
+	}
+
+	return user, nil
+}
+
+// findUserByName looks up a user by its userName, which is case-insensitive in SCIM
+func (h *SCIMHandlers) findUserByName(ctx context.Context, tailnetID uint64, userName string) (*domain.User, error) {
+	users, err := h.repository.ListUsers(ctx, tailnetID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	for _, u := range users {
+		if u.UserType == domain.UserTypePerson && strings.EqualFold(u.Name, userName) {
+			return &u, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (h *SCIMHandlers) findGroup(c echo.Context) (*domain.Group, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return nil, errSCIMGroupNotFound
+	}
+
+	group, err := h.repository.GetGroup(c.Request().Context(), id)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if group == nil || group.TailnetID != h.tailnet(c).ID {
+		return nil, errSCIMGroupNotFound
+	}
+
+	return group, nil
+}
+
+// checkGroupAlias rejects a group when another group of the tailnet is referenced by the same alias in the ACL policy,
+// e.g. "Engineering" and "engineering" would both become group:engineering
+func (h *SCIMHandlers) checkGroupAlias(ctx context.Context, group *domain.Group) error {
+	groups, err := h.repository.ListGroups(ctx, group.TailnetID)
+	if err != nil {
+		return logError(err)
+	}
+
+	for _, g := range groups {
+		if g.ID != group.ID && g.Alias() == group.Alias() {
+			return scimError(http.StatusConflict, "uniqueness", fmt.Sprintf("group '%s' conflicts with existing group '%s'", group.Name, g.Name))
+		}
+	}
+
+	return nil
+}
+
+func (h *SCIMHandlers) parseFilter(filter string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+
+	matches := scimFilterRegex.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", errSCIMInvalidFilter
+	}
+
+	return matches[1], matches[2], nil
+}
+
+func (h *SCIMHandlers) parseMembers(ctx context.Context, tailnetID uint64, members []scimMember) ([]uint64, error) {
+	var result []uint64
+	for _, m := range members {
+		id, err := strconv.ParseUint(m.Value, 10, 64)
+		if err != nil {
+			return nil, scimError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid member '%s'", m.Value))
+		}
+
+		user, err := h.repository.GetUser(ctx, id)
+		if err != nil {
+			return nil, logError(err)
+		}
+
+		if user == nil || user.TailnetID != tailnetID {
+			return nil, scimError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("unknown member '%s'", m.Value))
+		}
+
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func (h *SCIMHandlers) toSCIMUser(c echo.Context, u *domain.User) *scimUser {
+	active := !u.Suspended
+	id := strconv.FormatUint(u.ID, 10)
+
+	user := &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          id,
+		ExternalID:  u.ExternalID,
+		UserName:    u.Name,
+		DisplayName: u.Name,
+		Active:      &active,
+		Meta:        &scimMeta{ResourceType: "User", Location: h.location(c, "Users", id)},
+	}
+
+	if strings.Contains(u.Name, "@") {
+		user.Emails = []scimEmail{{Value: u.Name, Primary: true}}
+	}
+
+	return user
+}
+
+func (h *SCIMHandlers) toSCIMGroup(c echo.Context, g *domain.Group) *scimGroup {
+	id := strconv.FormatUint(g.ID, 10)
+
+	group := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          id,
+		ExternalID:  g.ExternalID,
+		DisplayName: g.Name,
+		Members:     []scimMember{},
+		Meta:        &scimMeta{ResourceType: "Group", Location: h.location(c, "Groups", id)},
+	}
+
+	for _, m := range g.Members {
+		group.Members = append(group.Members, scimMember{Value: strconv.FormatUint(m, 10)})
+	}
+
+	return group
+}
+
+func (h *SCIMHandlers) location(c echo.Context, resource, id string) string {
+	return fmt.Sprintf("%s://%s/scim/v2/%s/%s", c.Scheme(), c.Request().Host, resource, id)
+}
+
+func (h *SCIMHandlers) renderList(c echo.Context, resources []interface{}) error {
+	total := len(resources)
+
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count < 0 {
+		count = total
+	}
+
+	from := min(startIndex-1, total)
+	to := min(from+count, total)
+
+	page := resources[from:to]
+	if page == nil {
+		page = []interface{}{}
+	}
+
+	return h.render(c, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func (h *SCIMHandlers) render(c echo.Context, code int, i interface{}) error {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return logError(err)
+	}
+	return c.Blob(code, scimContentType, b)
+}
+
+// parseSCIMBool accepts both JSON booleans and strings, as some providers send "True" or "False"
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(strings.ToLower(s))
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestRepository(t *testing.T) domain.Repository {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+	return repository
+}
+
+func newTestTailnet(t *testing.T, repository domain.Repository, name string) *domain.Tailnet {
+	tailnet := &domain.Tailnet{
+		ID:        util.NextID(),
+		Name:      name,
+		IAMPolicy: domain.NewHuJSON(&domain.IAMPolicy{}),
+		ACLPolicy: domain.NewHuJSON(&domain.ACLPolicy{}),
+	}
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+	return tailnet
+}
+
+func newTestUser(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, name string) *domain.User {
+	user := &domain.User{ID: util.NextID(), Name: name, UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(context.Background(), user))
+	return user
+}
+
+func newTestMachine(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, user *domain.User) *domain.Machine {
+	ipv4, ipv6 := netip.MustParseAddr("100.64.0.1"), netip.MustParseAddr("fd7a:115c:a1e0::1")
+	id := util.NextID()
+	m := &domain.Machine{
+		ID:         id,
+		Name:       "machine-" + strconv.FormatUint(id, 10),
+		TailnetID:  tailnet.ID,
+		UserID:     user.ID,
+		Authorized: true,
+		IPv4:       domain.IP{Addr: &ipv4},
+		IPv6:       domain.IP{Addr: &ipv6},
+		CreatedAt:  time.Now().UTC(),
+		ExpiresAt:  time.Now().UTC().Add(time.Hour),
+	}
+	require.NoError(t, repository.SaveMachine(context.Background(), m))
+	return m
+}
+
+type scimTestServer struct {
+	t     *testing.T
+	e     *echo.Echo
+	token string
+}
+
+func newSCIMTestServer(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet) *scimTestServer {
+	h := NewSCIMHandlers(repository, core.NewPollMapSessionManager())
+
+	e := echo.New()
+	scim := e.Group("/scim/v2", h.Middleware)
+	scim.GET("/ServiceProviderConfig", h.ServiceProviderConfig)
+	scim.GET("/Users", h.ListUsers)
+	scim.POST("/Users", h.CreateUser)
+	scim.GET("/Users/:id", h.GetUser)
+	scim.PUT("/Users/:id", h.ReplaceUser)
+	scim.PATCH("/Users/:id", h.PatchUser)
+	scim.DELETE("/Users/:id", h.DeleteUser)
+	scim.GET("/Groups", h.ListGroups)
+	scim.POST("/Groups", h.CreateGroup)
+	scim.GET("/Groups/:id", h.GetGroup)
+	scim.PUT("/Groups/:id", h.ReplaceGroup)
+	scim.PATCH("/Groups/:id", h.PatchGroup)
+	scim.DELETE("/Groups/:id", h.DeleteGroup)
+
+	value, token := domain.CreateSCIMToken(tailnet)
+	require.NoError(t, repository.SaveSCIMToken(context.Background(), token))
+
+	return &scimTestServer{t: t, e: e, token: value}
+}
+
+func (s *scimTestServer) do(method, path string, body interface{}, result interface{}) int {
+	var reader = &bytes.Reader{}
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(s.t, err)
+		reader = bytes.NewReader(b)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", scimContentType)
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	rec := httptest.NewRecorder()
+	s.e.ServeHTTP(rec, req)
+
+	if result != nil && rec.Body.Len() != 0 {
+		require.NoError(s.t, json.Unmarshal(rec.Body.Bytes(), result))
+	}
+
+	return rec.Code
+}
+
+func TestSCIM_Authentication(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	other := newTestTailnet(t, repository, "other")
+	otherUser := newTestUser(t, repository, other, "jane@example.com")
+
+	s := newSCIMTestServer(t, repository, tailnet)
+	token := s.token
+
+	var errResponse scimErrorResponse
+
+	s.token = ""
+	require.Equal(t, http.StatusUnauthorized, s.do(http.MethodGet, "/scim/v2/Users", nil, &errResponse))
+	require.Equal(t, []string{scimSchemaError}, errResponse.Schemas)
+	require.Equal(t, "401", errResponse.Status)
+
+	s.token = "scim_invalid_token"
+	require.Equal(t, http.StatusUnauthorized, s.do(http.MethodGet, "/scim/v2/Users", nil, nil))
+
+	s.token = token
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, "/scim/v2/ServiceProviderConfig", nil, nil))
+
+	// resources of other tailnets are not accessible
+	require.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/scim/v2/Users/"+strconv.FormatUint(otherUser.ID, 10), nil, nil))
+
+	// rotating the token, as done when enabling SCIM again, revokes the previous token
+	rotated, newToken := domain.CreateSCIMToken(tailnet)
+	require.NoError(t, repository.Transaction(func(tx domain.Repository) error {
+		if err := tx.DeleteSCIMTokensByTailnet(ctx, tailnet.ID); err != nil {
+			return err
+		}
+		return tx.SaveSCIMToken(ctx, newToken)
+	}))
+
+	require.Equal(t, http.StatusUnauthorized, s.do(http.MethodGet, "/scim/v2/Users", nil, nil))
+
+	s.token = rotated
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, "/scim/v2/Users", nil, nil))
+}
+
+func TestSCIM_Users(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	s := newSCIMTestServer(t, repository, tailnet)
+
+	var created scimUser
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/scim/v2/Users", &scimUser{Schemas: []string{scimSchemaUser}, UserName: "john@example.com", ExternalID: "ext-john"}, &created))
+	require.Equal(t, "john@example.com", created.UserName)
+	require.True(t, *created.Active)
+
+	require.Equal(t, http.StatusConflict, s.do(http.MethodPost, "/scim/v2/Users", &scimUser{UserName: "John@example.com"}, nil))
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodPost, "/scim/v2/Users", &scimUser{}, nil))
+
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/scim/v2/Users", &scimUser{UserName: "jane@example.com"}, nil))
+
+	var list scimListResponse
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, "/scim/v2/Users", nil, &list))
+	require.Equal(t, 2, list.TotalResults)
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, `/scim/v2/Users?filter=userName+eq+%22JOHN@example.com%22`, nil, &list))
+	require.Equal(t, 1, list.TotalResults)
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, `/scim/v2/Users?filter=externalId+eq+%22ext-john%22`, nil, &list))
+	require.Equal(t, 1, list.TotalResults)
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, "/scim/v2/Users?startIndex=2&count=1", nil, &list))
+	require.Equal(t, 2, list.TotalResults)
+	require.Equal(t, 1, list.ItemsPerPage)
+
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodGet, `/scim/v2/Users?filter=emails+eq+%22john@example.com%22`, nil, nil))
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodGet, `/scim/v2/Users?filter=userName+sw+%22john%22`, nil, nil))
+
+	path := "/scim/v2/Users/" + created.ID
+	id, err := strconv.ParseUint(created.ID, 10, 64)
+	require.NoError(t, err)
+
+	var replaced scimUser
+	require.Equal(t, http.StatusOK, s.do(http.MethodPut, path, &scimUser{UserName: "john.doe@example.com", ExternalID: "ext-john"}, &replaced))
+	require.Equal(t, "john.doe@example.com", replaced.UserName)
+
+	require.Equal(t, http.StatusConflict, s.do(http.MethodPut, path, &scimUser{UserName: "jane@example.com"}, nil))
+
+	user, err := repository.GetUser(ctx, id)
+	require.NoError(t, err)
+	newTestMachine(t, repository, tailnet, user)
+
+	// deactivating a user removes its machines, some providers send booleans as strings
+	var patched scimUser
+	require.Equal(t, http.StatusOK, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)}},
+	}, &patched))
+	require.False(t, *patched.Active)
+
+	machines, err := repository.ListMachineByTailnet(ctx, tailnet.ID)
+	require.NoError(t, err)
+	require.Empty(t, machines)
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "replace", Value: json.RawMessage(`{"active":true,"userName":"john@example.com"}`)}},
+	}, &patched))
+	require.True(t, *patched.Active)
+	require.Equal(t, "john@example.com", patched.UserName)
+
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "remove", Path: "externalId"}},
+	}, nil))
+
+	require.Equal(t, http.StatusNoContent, s.do(http.MethodDelete, path, nil, nil))
+	require.Equal(t, http.StatusNotFound, s.do(http.MethodGet, path, nil, nil))
+	require.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/scim/v2/Users/unknown", nil, nil))
+}
+
+func TestSCIM_Groups(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	john := newTestUser(t, repository, tailnet, "john@example.com")
+	jane := newTestUser(t, repository, tailnet, "jane@example.com")
+
+	other := newTestTailnet(t, repository, "other")
+	stranger := newTestUser(t, repository, other, "joe@example.com")
+
+	s := newSCIMTestServer(t, repository, tailnet)
+
+	member := func(u *domain.User) scimMember {
+		return scimMember{Value: strconv.FormatUint(u.ID, 10)}
+	}
+
+	var created scimGroup
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/scim/v2/Groups", &scimGroup{DisplayName: "Engineering", Members: []scimMember{member(john)}}, &created))
+	require.Equal(t, []scimMember{member(john)}, created.Members)
+
+	// groups referenced by the same alias in the policy are rejected
+	require.Equal(t, http.StatusConflict, s.do(http.MethodPost, "/scim/v2/Groups", &scimGroup{DisplayName: "engineering"}, nil))
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodPost, "/scim/v2/Groups", &scimGroup{DisplayName: "Sales", Members: []scimMember{member(stranger)}}, nil))
+	require.Equal(t, http.StatusBadRequest, s.do(http.MethodPost, "/scim/v2/Groups", &scimGroup{}, nil))
+
+	var sales scimGroup
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/scim/v2/Groups", &scimGroup{DisplayName: "Sales"}, &sales))
+
+	var list scimListResponse
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, `/scim/v2/Groups?filter=displayName+eq+%22engineering%22&excludedAttributes=members`, nil, &list))
+	require.Equal(t, 1, list.TotalResults)
+	require.NotContains(t, list.Resources[0], "members")
+
+	path := "/scim/v2/Groups/" + created.ID
+
+	var patched scimGroup
+	require.Equal(t, http.StatusOK, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + member(jane).Value + `"}]`)}},
+	}, &patched))
+	require.ElementsMatch(t, []scimMember{member(john), member(jane)}, patched.Members)
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "remove", Path: `members[value eq "` + member(john).Value + `"]`}},
+	}, &patched))
+	require.Equal(t, []scimMember{member(jane)}, patched.Members)
+
+	require.Equal(t, http.StatusConflict, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "replace", Value: json.RawMessage(`{"displayName":"SALES"}`)}},
+	}, nil))
+
+	require.Equal(t, http.StatusOK, s.do(http.MethodPatch, path, &scimPatchRequest{
+		Operations: []scimPatchOperation{{Op: "replace", Value: json.RawMessage(`{"displayName":"Site Reliability"}`)}},
+	}, &patched))
+	require.Equal(t, "Site Reliability", patched.DisplayName)
+
+	policy, err := repository.GetACLPolicy(ctx, tailnet)
+	require.NoError(t, err)
+	require.Equal(t, []string{"jane@example.com"}, policy.Groups["group:site-reliability"])
+
+	var replaced scimGroup
+	require.Equal(t, http.StatusOK, s.do(http.MethodPut, path, &scimGroup{DisplayName: "Site Reliability", Members: []scimMember{member(john)}}, &replaced))
+	require.Equal(t, []scimMember{member(john)}, replaced.Members)
+
+	// deleting a user removes it from its groups
+	require.Equal(t, http.StatusNoContent, s.do(http.MethodDelete, "/scim/v2/Users/"+member(john).Value, nil, nil))
+	var group scimGroup
+	require.Equal(t, http.StatusOK, s.do(http.MethodGet, path, nil, &group))
+	require.Empty(t, group.Members)
+
+	require.Equal(t, http.StatusNoContent, s.do(http.MethodDelete, path, nil, nil))
+	require.Equal(t, http.StatusNotFound, s.do(http.MethodGet, path, nil, nil))
+}
+
+func TestSCIMHandlers_ParseFilter(t *testing.T) {
+	h := &SCIMHandlers{}
+
+	attr, value, err := h.parseFilter(`userName eq "john@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, "userName", attr)
+	require.Equal(t, "john@example.com", value)
+
+	attr, value, err = h.parseFilter("")
+	require.NoError(t, err)
+	require.Empty(t, attr)
+	require.Empty(t, value)
+
+	for _, f := range []string{`userName eq john`, `userName co "john"`, `userName eq "john" and active eq true`} {
+		_, _, err := h.parseFilter(f)
+		require.Equal(t, errSCIMInvalidFilter, err, f)
+	}
+}
@@ -0,0 +1,804 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/service"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	apiconnect "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1/ionscalev1connect"
+	"github.com/labstack/echo/v4"
+	"github.com/tailscale/hujson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	hujsonContentType = "application/hujson"
+)
+
+var exitNodeRoutes = []string{"0.0.0.0/0", "::/0"}
+
+func NewTailscaleAPIHandlers(repository domain.Repository, service apiconnect.IonscaleServiceHandler) *TailscaleAPIHandlers {
+	return &TailscaleAPIHandlers{
+		repository: repository,
+		service:    service,
+	}
+}
+
+// TailscaleAPIHandlers implements a subset of the Tailscale API v2 (https://tailscale.com/api) on top of the same service
+// as the RPC API, so existing tooling like the Tailscale Terraform provider can be used with ionscale.
+// Requests are authenticated with an ionscale API key, given as a bearer token or as the basic auth username.
+type TailscaleAPIHandlers struct {
+	repository domain.Repository
+	service    apiconnect.IonscaleServiceHandler
+}
+
+type tsDevice struct {
+	ID                 string                `json:"id"`
+	NodeID             string                `json:"nodeId"`
+	Addresses          []string              `json:"addresses"`
+	User               string                `json:"user"`
+	Name               string                `json:"name"`
+	Hostname           string                `json:"hostname"`
+	ClientVersion      string                `json:"clientVersion"`
+	UpdateAvailable    bool                  `json:"updateAvailable"`
+	OS                 string                `json:"os"`
+	Created            string                `json:"created"`
+	LastSeen           string                `json:"lastSeen,omitempty"`
+	ConnectedToControl bool                  `json:"connectedToControl"`
+	KeyExpiryDisabled  bool                  `json:"keyExpiryDisabled"`
+	Expires            string                `json:"expires"`
+	Authorized         bool                  `json:"authorized"`
+	IsExternal         bool                  `json:"isExternal"`
+	Tags               []string              `json:"tags"`
+	AdvertisedRoutes   []string              `json:"advertisedRoutes"`
+	EnabledRoutes      []string              `json:"enabledRoutes"`
+	ClientConnectivity *tsClientConnectivity `json:"clientConnectivity,omitempty"`
+}
+
+type tsClientConnectivity struct {
+	Endpoints []string `json:"endpoints"`
+}
+
+type tsRoutes struct {
+	AdvertisedRoutes []string `json:"advertisedRoutes"`
+	EnabledRoutes    []string `json:"enabledRoutes"`
+}
+
+type tsKey struct {
+	ID           string            `json:"id"`
+	Key          string            `json:"key,omitempty"`
+	Description  string            `json:"description"`
+	Created      string            `json:"created"`
+	Expires      string            `json:"expires,omitempty"`
+	Capabilities tsKeyCapabilities `json:"capabilities"`
+}
+
+type tsKeyCapabilities struct {
+	Devices struct {
+		Create struct {
+			Reusable      bool     `json:"reusable"`
+			Ephemeral     bool     `json:"ephemeral"`
+			Preauthorized bool     `json:"preauthorized"`
+			Tags          []string `json:"tags"`
+		} `json:"create"`
+	} `json:"devices"`
+}
+
+type tsCreateKeyRequest struct {
+	Capabilities  tsKeyCapabilities `json:"capabilities"`
+	ExpirySeconds int64             `json:"expirySeconds"`
+	Description   string            `json:"description"`
+}
+
+type tsNameservers struct {
+	DNS      []string `json:"dns"`
+	MagicDNS *bool    `json:"magicDNS,omitempty"`
+}
+
+type tsSearchPaths struct {
+	SearchPaths []string `json:"searchPaths"`
+}
+
+type tsDNSPreferences struct {
+	MagicDNS bool `json:"magicDNS"`
+}
+
+type tsError struct {
+	Message string `json:"message"`
+}
+
+func (h *TailscaleAPIHandlers) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+		if username, _, ok := c.Request().BasicAuth(); ok {
+			token = username
+		}
+
+		ctx, ok := service.ContextWithPrincipal(c.Request().Context(), h.repository, token)
+		if !ok {
+			return c.JSON(http.StatusUnauthorized, tsError{Message: "API token invalid"})
+		}
+
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
+
+func (h *TailscaleAPIHandlers) ListDevices(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tailnetID, err := h.resolveTailnet(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	suffix, err := h.magicDNSSuffix(ctx, tailnetID)
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	resp, err := h.service.ListMachines(ctx, connect.NewRequest(&api.ListMachinesRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var devices = []tsDevice{}
+	for _, m := range resp.Msg.Machines {
+		devices = append(devices, toTsDevice(m, suffix))
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{"devices": devices})
+}
+
+func (h *TailscaleAPIHandlers) GetDevice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	m, err := h.getMachine(ctx, c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	suffix, err := h.magicDNSSuffix(ctx, m.Tailnet.Id)
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTsDevice(m, suffix))
+}
+
+func (h *TailscaleAPIHandlers) DeleteDevice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	if _, err := h.service.DeleteMachine(ctx, connect.NewRequest(&api.DeleteMachineRequest{MachineId: machineID})); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *TailscaleAPIHandlers) ExpireDevice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	if _, err := h.service.ExpireMachine(ctx, connect.NewRequest(&api.ExpireMachineRequest{MachineId: machineID})); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *TailscaleAPIHandlers) AuthorizeDevice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var input struct {
+		Authorized bool `json:"authorized"`
+	}
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if !input.Authorized {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("deauthorizing a device is not supported")))
+	}
+
+	if _, err := h.service.AuthorizeMachine(ctx, connect.NewRequest(&api.AuthorizeMachineRequest{MachineId: machineID})); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *TailscaleAPIHandlers) SetDeviceKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var input struct {
+		KeyExpiryDisabled bool `json:"keyExpiryDisabled"`
+	}
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if _, err := h.service.SetMachineKeyExpiry(ctx, connect.NewRequest(&api.SetMachineKeyExpiryRequest{MachineId: machineID, Disabled: input.KeyExpiryDisabled})); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// SetDeviceName renames a device, an empty name resets the name to the hostname of the device
+func (h *TailscaleAPIHandlers) SetDeviceName(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var input struct {
+		Name string `json:"name"`
+	}
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	// the name can be given as a fully qualified domain name, only the machine name can be changed
+	name, _, _ := strings.Cut(input.Name, ".")
+
+	req := &api.SetMachineNameRequest{MachineId: machineID, Name: name, UseOsHostname: name == ""}
+	if _, err := h.service.SetMachineName(ctx, connect.NewRequest(req)); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *TailscaleAPIHandlers) GetDeviceRoutes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	resp, err := h.service.GetMachineRoutes(ctx, connect.NewRequest(&api.GetMachineRoutesRequest{MachineId: machineID}))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTsRoutes(resp.Msg.Routes))
+}
+
+// SetDeviceRoutes replaces the enabled routes of a device, the default routes 0.0.0.0/0 and ::/0 enable it as exit node
+func (h *TailscaleAPIHandlers) SetDeviceRoutes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	machineID, err := parseTsID(c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var input struct {
+		Routes []string `json:"routes"`
+	}
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	var routes = []string{}
+	var exitNode = false
+	for _, r := range input.Routes {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		if prefix.Bits() == 0 {
+			exitNode = true
+		} else {
+			routes = append(routes, prefix.String())
+		}
+	}
+
+	enableReq := &api.EnableMachineRoutesRequest{MachineId: machineID, Routes: routes, Replace: true}
+	resp, err := h.service.EnableMachineRoutes(ctx, connect.NewRequest(enableReq))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	result := resp.Msg.Routes
+
+	if exitNode {
+		resp, err := h.service.EnableExitNode(ctx, connect.NewRequest(&api.EnableExitNodeRequest{MachineId: machineID}))
+		if err != nil {
+			return tsErrorResponse(c, err)
+		}
+		result = resp.Msg.Routes
+	} else if result.EnabledExitNode {
+		resp, err := h.service.DisableExitNode(ctx, connect.NewRequest(&api.DisableExitNodeRequest{MachineId: machineID}))
+		if err != nil {
+			return tsErrorResponse(c, err)
+		}
+		result = resp.Msg.Routes
+	}
+
+	return c.JSON(http.StatusOK, toTsRoutes(result))
+}
+
+func (h *TailscaleAPIHandlers) ListKeys(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tailnetID, err := h.resolveTailnet(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	resp, err := h.service.ListAuthKeys(ctx, connect.NewRequest(&api.ListAuthKeysRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var keys = []tsKey{}
+	for _, k := range resp.Msg.AuthKeys {
+		keys = append(keys, toTsKey(k, ""))
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{"keys": keys})
+}
+
+func (h *TailscaleAPIHandlers) GetKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	key, err := h.getAuthKey(ctx, c.Param("tailnet"), c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTsKey(key, ""))
+}
+
+// CreateKey creates an auth key, ionscale auth keys are always reusable, so the reusable capability is ignored
+func (h *TailscaleAPIHandlers) CreateKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tailnetID, err := h.resolveTailnet(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	var input tsCreateKeyRequest
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	// keys expire after 90 days by default, as with Tailscale
+	expiry := 90 * 24 * time.Hour
+	if input.ExpirySeconds > 0 {
+		expiry = time.Duration(input.ExpirySeconds) * time.Second
+	}
+
+	create := input.Capabilities.Devices.Create
+	req := &api.CreateAuthKeyRequest{
+		TailnetId:     tailnetID,
+		Ephemeral:     create.Ephemeral,
+		PreAuthorized: create.Preauthorized,
+		Tags:          create.Tags,
+		Expiry:        durationpb.New(expiry),
+		Description:   input.Description,
+	}
+
+	resp, err := h.service.CreateAuthKey(ctx, connect.NewRequest(req))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTsKey(resp.Msg.AuthKey, resp.Msg.Value))
+}
+
+func (h *TailscaleAPIHandlers) DeleteKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	key, err := h.getAuthKey(ctx, c.Param("tailnet"), c.Param("id"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	if _, err := h.service.DeleteAuthKey(ctx, connect.NewRequest(&api.DeleteAuthKeyRequest{AuthKeyId: key.Id})); err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *TailscaleAPIHandlers) GetACL(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tailnetID, err := h.resolveTailnet(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return h.renderACL(c, tailnetID)
+}
+
+// SetACL replaces the ACL policy, when an If-Match header is given the current policy should match the given ETag
+func (h *TailscaleAPIHandlers) SetACL(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tailnetID, err := h.resolveTailnet(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return logError(err)
+	}
+
+	req := &api.SetACLPolicyRequest{TailnetId: tailnetID, Policy: string(body)}
+	if ifMatch := c.Request().Header.Get("If-Match"); ifMatch != "" && ifMatch != "ts-default" {
+		// the hash is compared by the service while updating, so concurrent writers with the same ETag can't both succeed
+		req.PreviousHash = strings.Trim(ifMatch, `"`)
+	}
+
+	if _, err := h.service.SetACLPolicy(ctx, connect.NewRequest(req)); err != nil {
+		if connect.CodeOf(err) == connect.CodeAborted {
+			return c.JSON(http.StatusPreconditionFailed, tsError{Message: "precondition failed, invalid old hash"})
+		}
+		return tsErrorResponse(c, err)
+	}
+
+	return h.renderACL(c, tailnetID)
+}
+
+func (h *TailscaleAPIHandlers) GetNameservers(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	_, dnsConfig, err := h.getDNSConfig(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsNameservers{DNS: nonNil(dnsConfig.Nameservers)})
+}
+
+func (h *TailscaleAPIHandlers) SetNameservers(c echo.Context) error {
+	var input tsNameservers
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	dnsConfig, err := h.updateDNSConfig(c, func(config *api.DNSConfig) {
+		config.Nameservers = input.DNS
+	})
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsNameservers{DNS: nonNil(dnsConfig.Nameservers), MagicDNS: &dnsConfig.MagicDns})
+}
+
+func (h *TailscaleAPIHandlers) GetSearchPaths(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	_, dnsConfig, err := h.getDNSConfig(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsSearchPaths{SearchPaths: nonNil(dnsConfig.SearchDomains)})
+}
+
+func (h *TailscaleAPIHandlers) SetSearchPaths(c echo.Context) error {
+	var input tsSearchPaths
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	dnsConfig, err := h.updateDNSConfig(c, func(config *api.DNSConfig) {
+		config.SearchDomains = input.SearchPaths
+	})
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsSearchPaths{SearchPaths: nonNil(dnsConfig.SearchDomains)})
+}
+
+func (h *TailscaleAPIHandlers) GetDNSPreferences(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	_, dnsConfig, err := h.getDNSConfig(ctx, c.Param("tailnet"))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsDNSPreferences{MagicDNS: dnsConfig.MagicDns})
+}
+
+func (h *TailscaleAPIHandlers) SetDNSPreferences(c echo.Context) error {
+	var input tsDNSPreferences
+	if err := c.Bind(&input); err != nil {
+		return tsErrorResponse(c, connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	dnsConfig, err := h.updateDNSConfig(c, func(config *api.DNSConfig) {
+		config.MagicDns = input.MagicDNS
+	})
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tsDNSPreferences{MagicDNS: dnsConfig.MagicDns})
+}
+
+func (h *TailscaleAPIHandlers) renderACL(c echo.Context, tailnetID uint64) error {
+	resp, err := h.service.GetACLPolicy(c.Request().Context(), connect.NewRequest(&api.GetACLPolicyRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return tsErrorResponse(c, err)
+	}
+
+	policy := resp.Msg.Policy
+	c.Response().Header().Set("ETag", aclETag(policy))
+
+	if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), hujsonContentType) {
+		return c.Blob(http.StatusOK, hujsonContentType, []byte(policy))
+	}
+
+	standardized, err := hujson.Standardize([]byte(policy))
+	if err != nil {
+		return logError(err)
+	}
+
+	return c.JSONBlob(http.StatusOK, standardized)
+}
+
+func (h *TailscaleAPIHandlers) updateDNSConfig(c echo.Context, update func(config *api.DNSConfig)) (*api.DNSConfig, error) {
+	ctx := c.Request().Context()
+
+	tailnetID, dnsConfig, err := h.getDNSConfig(ctx, c.Param("tailnet"))
+	if err != nil {
+		return nil, err
+	}
+
+	update(dnsConfig)
+
+	if _, err := h.service.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{TailnetId: tailnetID, Config: dnsConfig})); err != nil {
+		return nil, err
+	}
+
+	_, dnsConfig, err = h.getDNSConfig(ctx, c.Param("tailnet"))
+	return dnsConfig, err
+}
+
+func (h *TailscaleAPIHandlers) getDNSConfig(ctx context.Context, tailnet string) (uint64, *api.DNSConfig, error) {
+	tailnetID, err := h.resolveTailnet(ctx, tailnet)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := h.service.GetDNSConfig(ctx, connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return tailnetID, resp.Msg.Config, nil
+}
+
+func (h *TailscaleAPIHandlers) magicDNSSuffix(ctx context.Context, tailnetID uint64) (string, error) {
+	resp, err := h.service.GetDNSConfig(ctx, connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: tailnetID}))
+	if err != nil {
+		return "", err
+	}
+	return resp.Msg.Config.MagicDnsSuffix, nil
+}
+
+func (h *TailscaleAPIHandlers) getMachine(ctx context.Context, id string) (*api.Machine, error) {
+	machineID, err := parseTsID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.service.GetMachine(ctx, connect.NewRequest(&api.GetMachineRequest{MachineId: machineID}))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Machine, nil
+}
+
+func (h *TailscaleAPIHandlers) getAuthKey(ctx context.Context, tailnet string, id string) (*api.AuthKey, error) {
+	tailnetID, err := h.resolveTailnet(ctx, tailnet)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := parseTsID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.service.GetAuthKey(ctx, connect.NewRequest(&api.GetAuthKeyRequest{AuthKeyId: keyID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Msg.AuthKey.Tailnet.Id != tailnetID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("key not found"))
+	}
+
+	return resp.Msg.AuthKey, nil
+}
+
+// resolveTailnet finds the tailnet by name or by id, '-' refers to the tailnet of the API key
+func (h *TailscaleAPIHandlers) resolveTailnet(ctx context.Context, tailnet string) (uint64, error) {
+	principal := service.CurrentPrincipal(ctx)
+	if tailnet == "-" {
+		if principal.User == nil {
+			return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the default tailnet '-' is only available for tailnet API keys"))
+		}
+		return principal.User.TailnetID, nil
+	}
+
+	resp, err := h.service.ListTailnets(ctx, connect.NewRequest(&api.ListTailnetsRequest{}))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, t := range resp.Msg.Tailnet {
+		if t.Name == tailnet || strconv.FormatUint(t.Id, 10) == tailnet {
+			return t.Id, nil
+		}
+	}
+
+	return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+}
+
+func toTsDevice(m *api.Machine, magicDNSSuffix string) tsDevice {
+	id := strconv.FormatUint(m.Id, 10)
+
+	name := m.Name
+	if magicDNSSuffix != "" {
+		name = fmt.Sprintf("%s.%s", m.Name, magicDNSSuffix)
+	}
+
+	routes := toTsRoutes(&api.MachineRoutes{
+		AdvertisedRoutes:   m.AdvertisedRoutes,
+		EnabledRoutes:      m.EnabledRoutes,
+		AdvertisedExitNode: m.AdvertisedExitNode,
+		EnabledExitNode:    m.EnabledExitNode,
+	})
+
+	device := tsDevice{
+		ID:                 id,
+		NodeID:             id,
+		Addresses:          []string{m.Ipv4, m.Ipv6},
+		User:               m.User.Name,
+		Name:               name,
+		Hostname:           m.Name,
+		ClientVersion:      m.ClientVersion,
+		OS:                 m.Os,
+		Created:            tsTime(m.CreatedAt),
+		LastSeen:           tsTime(m.LastSeen),
+		ConnectedToControl: m.Connected,
+		KeyExpiryDisabled:  m.KeyExpiryDisabled,
+		Expires:            tsTime(m.ExpiresAt),
+		Authorized:         m.Authorized,
+		Tags:               nonNil(m.Tags),
+		AdvertisedRoutes:   routes.AdvertisedRoutes,
+		EnabledRoutes:      routes.EnabledRoutes,
+	}
+
+	if m.ClientConnectivity != nil {
+		device.ClientConnectivity = &tsClientConnectivity{Endpoints: nonNil(m.ClientConnectivity.Endpoints)}
+	}
+
+	return device
+}
+
+func toTsRoutes(r *api.MachineRoutes) tsRoutes {
+	result := tsRoutes{
+		AdvertisedRoutes: append([]string{}, r.AdvertisedRoutes...),
+		EnabledRoutes:    append([]string{}, r.EnabledRoutes...),
+	}
+	if r.AdvertisedExitNode {
+		result.AdvertisedRoutes = append(result.AdvertisedRoutes, exitNodeRoutes...)
+	}
+	if r.EnabledExitNode {
+		result.EnabledRoutes = append(result.EnabledRoutes, exitNodeRoutes...)
+	}
+	return result
+}
+
+func toTsKey(k *api.AuthKey, value string) tsKey {
+	key := tsKey{
+		ID:          strconv.FormatUint(k.Id, 10),
+		Key:         value,
+		Description: k.Description,
+		Created:     tsTime(k.CreatedAt),
+		Expires:     tsTime(k.ExpiresAt),
+	}
+	key.Capabilities.Devices.Create.Reusable = true
+	key.Capabilities.Devices.Create.Ephemeral = k.Ephemeral
+	key.Capabilities.Devices.Create.Tags = nonNil(k.Tags)
+	return key
+}
+
+func aclETag(policy string) string {
+	sum := sha256.Sum256([]byte(policy))
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:]))
+}
+
+func tsTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
+
+func parseTsID(id string) (uint64, error) {
+	v, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("invalid id '%s'", id))
+	}
+	return v, nil
+}
+
+func nonNil(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+func tsErrorResponse(c echo.Context, err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return logError(err)
+	}
+
+	code := http.StatusInternalServerError
+	switch connectErr.Code() {
+	case connect.CodeUnauthenticated:
+		code = http.StatusUnauthorized
+	case connect.CodePermissionDenied:
+		code = http.StatusForbidden
+	case connect.CodeNotFound:
+		code = http.StatusNotFound
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition:
+		code = http.StatusBadRequest
+	case connect.CodeAlreadyExists, connect.CodeAborted:
+		code = http.StatusConflict
+	}
+
+	return c.JSON(code, tsError{Message: connectErr.Message()})
+}
@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/service"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type tsApiTestServer struct {
+	t *testing.T
+	e *echo.Echo
+}
+
+func newTsApiTestServer(t *testing.T, repository domain.Repository) *tsApiTestServer {
+	c := &config.Config{PublicUrl: &url.URL{Scheme: "https", Host: "ionscale.example.com"}}
+	h := NewTailscaleAPIHandlers(repository, service.NewService(c, nil, nil, repository, core.NewPollMapSessionManager(), nil))
+
+	e := echo.New()
+	tsApi := e.Group("/api/v2", h.Middleware)
+	tsApi.GET("/tailnet/:tailnet/devices", h.ListDevices)
+	tsApi.GET("/device/:id", h.GetDevice)
+	tsApi.DELETE("/device/:id", h.DeleteDevice)
+	tsApi.POST("/device/:id/authorized", h.AuthorizeDevice)
+	tsApi.GET("/device/:id/routes", h.GetDeviceRoutes)
+	tsApi.POST("/device/:id/routes", h.SetDeviceRoutes)
+	tsApi.GET("/tailnet/:tailnet/keys", h.ListKeys)
+	tsApi.POST("/tailnet/:tailnet/keys", h.CreateKey)
+	tsApi.GET("/tailnet/:tailnet/keys/:id", h.GetKey)
+	tsApi.DELETE("/tailnet/:tailnet/keys/:id", h.DeleteKey)
+	tsApi.GET("/tailnet/:tailnet/acl", h.GetACL)
+	tsApi.POST("/tailnet/:tailnet/acl", h.SetACL)
+
+	return &tsApiTestServer{t: t, e: e}
+}
+
+func (s *tsApiTestServer) do(method, path, token string, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	s.e.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *tsApiTestServer) decode(rec *httptest.ResponseRecorder, v interface{}) {
+	require.NoError(s.t, json.Unmarshal(rec.Body.Bytes(), v), rec.Body.String())
+}
+
+func newTsApiKey(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, user *domain.User) string {
+	token, apiKey := domain.CreateApiKey(tailnet, user, nil)
+	require.NoError(t, repository.SaveApiKey(context.Background(), apiKey))
+	return token
+}
+
+func newTsApiAdmin(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, name string) *domain.User {
+	user := newTestUser(t, repository, tailnet, name)
+	tailnet.IAMPolicy = domain.NewHuJSON(&domain.IAMPolicy{Roles: map[string]domain.UserRole{user.Name: domain.UserRoleAdmin}})
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+	return user
+}
+
+func TestTailscaleAPI_Authentication(t *testing.T) {
+	repository := newTestRepository(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	token := newTsApiKey(t, repository, tailnet, newTsApiAdmin(t, repository, tailnet, "admin@example.com"))
+
+	s := newTsApiTestServer(t, repository)
+
+	rec := s.do(http.MethodGet, "/api/v2/tailnet/-/devices", "", "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/-/devices", "invalid", "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/-/devices", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the Tailscale clients send the API key as the basic auth username
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/tailnet/tailnet/devices", nil)
+	req.SetBasicAuth(token, "")
+	basic := httptest.NewRecorder()
+	s.e.ServeHTTP(basic, req)
+	require.Equal(t, http.StatusOK, basic.Code)
+
+	// the default tailnet '-' is not available for system API keys
+	account, _, err := repository.GetOrCreateAccount(context.Background(), domain.DefaultAuthProvider, "admin", "admin@example.com")
+	require.NoError(t, err)
+	expiresAt := time.Now().Add(time.Hour)
+	systemToken, systemApiKey := domain.CreateSystemApiKey(account, &expiresAt)
+	require.NoError(t, repository.SaveSystemApiKey(context.Background(), systemApiKey))
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/-/devices", systemToken, "", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = s.do(http.MethodGet, fmt.Sprintf("/api/v2/tailnet/%d/devices", tailnet.ID), systemToken, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestTailscaleAPI_Devices(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	admin := newTsApiAdmin(t, repository, tailnet, "admin@example.com")
+	token := newTsApiKey(t, repository, tailnet, admin)
+
+	m := newTestMachine(t, repository, tailnet, admin)
+	m.Authorized = false
+	m.HostInfo.RoutableIPs = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")}
+	require.NoError(t, repository.SaveMachine(ctx, m))
+
+	other := newTestTailnet(t, repository, "other")
+	otherMachine := newTestMachine(t, repository, other, newTestUser(t, repository, other, "jane@example.com"))
+
+	s := newTsApiTestServer(t, repository)
+	devicePath := fmt.Sprintf("/api/v2/device/%d", m.ID)
+
+	rec := s.do(http.MethodGet, "/api/v2/tailnet/-/devices", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var devices struct {
+		Devices []tsDevice `json:"devices"`
+	}
+	s.decode(rec, &devices)
+	require.Len(t, devices.Devices, 1)
+	require.Equal(t, strconv.FormatUint(m.ID, 10), devices.Devices[0].ID)
+	require.Equal(t, []string{"100.64.0.1", "fd7a:115c:a1e0::1"}, devices.Devices[0].Addresses)
+	require.False(t, devices.Devices[0].Authorized)
+
+	rec = s.do(http.MethodPost, devicePath+"/authorized", token, `{"authorized":false}`, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = s.do(http.MethodPost, devicePath+"/authorized", token, `{"authorized":true}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.do(http.MethodGet, devicePath, token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var device tsDevice
+	s.decode(rec, &device)
+	require.True(t, device.Authorized)
+
+	rec = s.do(http.MethodPost, devicePath+"/routes", token, `{"routes":["10.0.0.0/24","0.0.0.0/0","::/0"]}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var routes tsRoutes
+	s.decode(rec, &routes)
+	require.Equal(t, []string{"10.0.0.0/24", "0.0.0.0/0", "::/0"}, routes.EnabledRoutes)
+
+	rec = s.do(http.MethodPost, devicePath+"/routes", token, `{"routes":["10.0.0.0/24"]}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.do(http.MethodGet, devicePath+"/routes", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	s.decode(rec, &routes)
+	require.Equal(t, []string{"10.0.0.0/24", "0.0.0.0/0", "::/0"}, routes.AdvertisedRoutes)
+	require.Equal(t, []string{"10.0.0.0/24"}, routes.EnabledRoutes)
+
+	rec = s.do(http.MethodPost, devicePath+"/routes", token, `{"routes":["invalid"]}`, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// devices of other tailnets are not accessible
+	rec = s.do(http.MethodGet, fmt.Sprintf("/api/v2/device/%d", otherMachine.ID), token, "", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = s.do(http.MethodDelete, fmt.Sprintf("/api/v2/device/%d", otherMachine.ID), token, "", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = s.do(http.MethodDelete, devicePath, token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.do(http.MethodGet, devicePath, token, "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = s.do(http.MethodGet, "/api/v2/device/invalid", token, "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestTailscaleAPI_Keys(t *testing.T) {
+	repository := newTestRepository(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	token := newTsApiKey(t, repository, tailnet, newTsApiAdmin(t, repository, tailnet, "admin@example.com"))
+
+	other := newTestTailnet(t, repository, "other")
+	otherToken := newTsApiKey(t, repository, other, newTsApiAdmin(t, repository, other, "jane@example.com"))
+
+	s := newTsApiTestServer(t, repository)
+
+	rec := s.do(http.MethodPost, "/api/v2/tailnet/-/keys", token, `{"description":"ci runners","expirySeconds":3600,"capabilities":{"devices":{"create":{"ephemeral":true}}}}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var created tsKey
+	s.decode(rec, &created)
+	require.NotEmpty(t, created.Key)
+	require.Equal(t, "ci runners", created.Description)
+	require.True(t, created.Capabilities.Devices.Create.Reusable)
+	require.True(t, created.Capabilities.Devices.Create.Ephemeral)
+
+	keyPath := "/api/v2/tailnet/-/keys/" + created.ID
+
+	rec = s.do(http.MethodGet, keyPath, token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var key tsKey
+	s.decode(rec, &key)
+	require.Equal(t, created.ID, key.ID)
+	require.Empty(t, key.Key)
+	require.Equal(t, "ci runners", key.Description)
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/-/keys", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var keys struct {
+		Keys []tsKey `json:"keys"`
+	}
+	s.decode(rec, &keys)
+	require.Len(t, keys.Keys, 1)
+	require.Equal(t, "ci runners", keys.Keys[0].Description)
+
+	// keys of other tailnets are not accessible
+	rec = s.do(http.MethodGet, keyPath, otherToken, "", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = s.do(http.MethodDelete, "/api/v2/tailnet/other/keys/"+created.ID, otherToken, "", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = s.do(http.MethodDelete, keyPath, token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.do(http.MethodGet, keyPath, token, "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/unknown/keys", token, "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestTailscaleAPI_ACL(t *testing.T) {
+	repository := newTestRepository(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	admin := newTsApiAdmin(t, repository, tailnet, "admin@example.com")
+	token := newTsApiKey(t, repository, tailnet, admin)
+	memberToken := newTsApiKey(t, repository, tailnet, newTestUser(t, repository, tailnet, "john@example.com"))
+
+	s := newTsApiTestServer(t, repository)
+
+	rec := s.do(http.MethodGet, "/api/v2/tailnet/-/acl", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	policy := `{
+  // allow everything
+  "acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}],
+}`
+
+	rec = s.do(http.MethodPost, "/api/v2/tailnet/-/acl", memberToken, policy, nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = s.do(http.MethodPost, "/api/v2/tailnet/-/acl", token, policy, map[string]string{"If-Match": `"invalid"`})
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = s.do(http.MethodPost, "/api/v2/tailnet/-/acl", token, policy, map[string]string{"If-Match": etag, echo.HeaderAccept: hujsonContentType})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, hujsonContentType, rec.Header().Get(echo.HeaderContentType))
+	require.Contains(t, rec.Body.String(), "// allow everything")
+	newETag := rec.Header().Get("ETag")
+	require.NotEqual(t, etag, newETag)
+
+	// a second writer with the previous ETag doesn't overwrite the policy
+	rec = s.do(http.MethodPost, "/api/v2/tailnet/-/acl", token, `{"acls": []}`, map[string]string{"If-Match": etag})
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = s.do(http.MethodGet, "/api/v2/tailnet/-/acl", token, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, newETag, rec.Header().Get("ETag"))
+	require.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+	require.NotContains(t, rec.Body.String(), "allow everything")
+
+	rec = s.do(http.MethodPost, "/api/v2/tailnet/-/acl", token, `{"acls": [`, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestTailscaleAPI_ErrorResponse(t *testing.T) {
+	tests := map[connect.Code]int{
+		connect.CodeUnauthenticated:    http.StatusUnauthorized,
+		connect.CodePermissionDenied:   http.StatusForbidden,
+		connect.CodeNotFound:           http.StatusNotFound,
+		connect.CodeInvalidArgument:    http.StatusBadRequest,
+		connect.CodeFailedPrecondition: http.StatusBadRequest,
+		connect.CodeAlreadyExists:      http.StatusConflict,
+		connect.CodeAborted:            http.StatusConflict,
+		connect.CodeInternal:           http.StatusInternalServerError,
+		connect.CodeUnimplemented:      http.StatusInternalServerError,
+		connect.CodeResourceExhausted:  http.StatusInternalServerError,
+		connect.CodeDeadlineExceeded:   http.StatusInternalServerError,
+		connect.CodeUnavailable:        http.StatusInternalServerError,
+		connect.CodeDataLoss:           http.StatusInternalServerError,
+		connect.CodeCanceled:           http.StatusInternalServerError,
+		connect.CodeOutOfRange:         http.StatusInternalServerError,
+		connect.CodeUnknown:            http.StatusInternalServerError,
+	}
+
+	e := echo.New()
+	for code, status := range tests {
+		t.Run(code.String(), func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			require.NoError(t, tsErrorResponse(c, connect.NewError(code, fmt.Errorf("some error"))))
+			require.Equal(t, status, rec.Code)
+
+			body, err := io.ReadAll(rec.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"message":"some error"}`, string(body))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package mapping
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/domain"
@@ -15,10 +16,9 @@ import (
 )
 
 func ToDNSConfig(m *domain.Machine, tailnet *domain.Tailnet, c *domain.DNSConfig) *tailcfg.DNSConfig {
-	certsEnabled := c.HttpsCertsEnabled && config.DNSProviderConfigured()
+	tailnetDomain := tailnet.DNSDomain(config.MagicDNSSuffix())
 
-	sanitizeTailnetName := domain.SanitizeTailnetName(tailnet.Name)
-	tailnetDomain := fmt.Sprintf("%s.%s", sanitizeTailnetName, config.MagicDNSSuffix())
+	certsEnabled := c.HttpsCertsEnabled && config.DNSProviderConfigured() && domain.IsSubdomain(tailnetDomain, config.DNSProviderZone())
 
 	resolvers := make([]*dnstype.Resolver, 0)
 
@@ -63,14 +63,26 @@ func ToDNSConfig(m *domain.Machine, tailnet *domain.Tailnet, c *domain.DNSConfig
 	dnsConfig.Domains = append(domains, c.SearchDomains...)
 	dnsConfig.CertDomains = certDomains
 
+	for _, r := range c.ExtraRecords {
+		dnsConfig.ExtraRecords = append(dnsConfig.ExtraRecords, tailcfg.DNSRecord{
+			Name:  r.Name,
+			Type:  r.Type,
+			Value: r.Value,
+		})
+	}
+
 	dnsConfig.ExitNodeFilteredSet = []string{
 		fmt.Sprintf(".%s", config.MagicDNSSuffix()),
 	}
 
+	if !domain.IsSubdomain(tailnetDomain, config.MagicDNSSuffix()) {
+		dnsConfig.ExitNodeFilteredSet = append(dnsConfig.ExitNodeFilteredSet, fmt.Sprintf(".%s", tailnetDomain))
+	}
+
 	return dnsConfig
 }
 
-func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain.Tailnet, taggedDevicesUser *domain.User, peer bool, connected bool, routeFilter func(m *domain.Machine) []netip.Prefix) (*tailcfg.Node, *tailcfg.UserProfile, error) {
+func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain.Tailnet, policy *domain.ACLPolicy, taggedDevicesUser *domain.User, peer bool, connected bool, routeFilter func(m *domain.Machine) []netip.Prefix) (*tailcfg.Node, *tailcfg.UserProfile, error) {
 	role := tailnet.IAMPolicy.Get().GetRole(m.User)
 
 	nKey, err := util.ParseNodePublicKey(m.NodeKey)
@@ -136,7 +148,7 @@ func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain
 
 	var name = m.CompleteName()
 
-	sanitizedTailnetName := domain.SanitizeTailnetName(m.Tailnet.Name)
+	tailnetDomain := m.Tailnet.DNSDomain(config.MagicDNSSuffix())
 
 	hostInfo := tailcfg.Hostinfo{
 		OS:           hostinfo.OS,
@@ -148,7 +160,7 @@ func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain
 	n := tailcfg.Node{
 		ID:               tailcfg.NodeID(m.ID),
 		StableID:         tailcfg.StableNodeID(strconv.FormatUint(m.ID, 10)),
-		Name:             fmt.Sprintf("%s.%s.%s.", name, sanitizedTailnetName, config.MagicDNSSuffix()),
+		Name:             fmt.Sprintf("%s.%s.", name, tailnetDomain),
 		Key:              *nKey,
 		Machine:          *mKey,
 		DiscoKey:         discoKey,
@@ -167,11 +179,24 @@ func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain
 
 	if !peer {
 		var capabilities []tailcfg.NodeCapability
-		capMap := make(tailcfg.NodeCapMap)
+		capMap := policy.NodeCapMap(m)
 
-		for _, c := range tailnet.ACLPolicy.Get().NodeCapabilities(m) {
-			capabilities = append(capabilities, c)
-			capMap[c] = []tailcfg.RawMessage{}
+		// clients before capability version 74 only support capabilities without values
+		for c, values := range capMap {
+			if len(values) == 0 {
+				capabilities = append(capabilities, c)
+			}
+		}
+		slices.Sort(capabilities)
+
+		if connectors := policy.AppConnectorAttrs(m); len(connectors) != 0 {
+			for _, c := range connectors {
+				v, err := json.Marshal(c)
+				if err != nil {
+					return nil, nil, err
+				}
+				capMap[domain.AppConnectorsCapability] = append(capMap[domain.AppConnectorsCapability], tailcfg.RawMessage(v))
+			}
 		}
 
 		if !m.HasTags() && role == domain.UserRoleAdmin {
@@ -196,7 +221,7 @@ func ToNode(capVer tailcfg.CapabilityVersion, m *domain.Machine, tailnet *domain
 
 		// ionscale has no support for Funnel yet, so remove Funnel attribute if set via ACL policy
 		{
-			slices.DeleteFunc(capabilities, func(c tailcfg.NodeCapability) bool { return c == tailcfg.NodeAttrFunnel })
+			capabilities = slices.DeleteFunc(capabilities, func(c tailcfg.NodeCapability) bool { return c == tailcfg.NodeAttrFunnel })
 			delete(capMap, tailcfg.NodeAttrFunnel)
 		}
 
@@ -268,3 +293,34 @@ func filterServices(services []tailcfg.Service) []tailcfg.Service {
 	}
 	return result
 }
+
+// ToAppConnectorDNSRoutes routes the DNS queries for the domains of the app connectors to the connectors,
+// so the connectors learn the addresses of those domains and advertise routes for them.
+func ToAppConnectorDNSRoutes(policy *domain.ACLPolicy, peers []domain.Machine) map[string][]*dnstype.Resolver {
+	routes := make(map[string][]*dnstype.Resolver)
+
+	for _, p := range peers {
+		hostinfo := tailcfg.Hostinfo(p.HostInfo)
+		if !hostinfo.AppConnector.EqualBool(true) || !p.IPv4.IsValid() {
+			continue
+		}
+
+		var port uint16
+		for _, s := range hostinfo.Services {
+			if s.Proto == tailcfg.PeerAPI4 {
+				port = s.Port
+			}
+		}
+
+		if port == 0 {
+			continue
+		}
+
+		resolver := &dnstype.Resolver{Addr: fmt.Sprintf("http://%s/dns-query", netip.AddrPortFrom(*p.IPv4.Addr, port))}
+		for _, d := range domain.AppConnectorDomains(policy.AppConnectorAttrs(&p)) {
+			routes[d] = append(routes[d], resolver)
+		}
+	}
+
+	return routes
+}
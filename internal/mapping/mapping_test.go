@@ -0,0 +1,120 @@
+package mapping
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/appctype"
+	"tailscale.com/types/key"
+)
+
+func newToNodeMachine(tailnet *domain.Tailnet, tags ...string) *domain.Machine {
+	ipv4, ipv6 := netip.MustParseAddr("100.64.0.1"), netip.MustParseAddr("fd7a:115c:a1e0::1")
+	return &domain.Machine{
+		ID:         1,
+		Name:       "machine",
+		NodeKey:    key.NewNode().Public().String(),
+		MachineKey: key.NewMachine().Public().String(),
+		Tags:       tags,
+		IPv4:       domain.IP{Addr: &ipv4},
+		IPv6:       domain.IP{Addr: &ipv6},
+		CreatedAt:  time.Now().UTC(),
+		ExpiresAt:  time.Now().UTC().Add(time.Hour),
+		TailnetID:  tailnet.ID,
+		Tailnet:    *tailnet,
+		UserID:     2,
+		User:       domain.User{ID: 2, Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID},
+	}
+}
+
+func TestToNode_Capabilities(t *testing.T) {
+	tailnet := &domain.Tailnet{
+		ID:                 1,
+		Name:               "tailnet",
+		IAMPolicy:          domain.NewHuJSON(&domain.IAMPolicy{}),
+		FileSharingEnabled: true,
+	}
+
+	policy := &domain.ACLPolicy{ACLPolicy: ionscale.ACLPolicy{
+		NodeAttrs: []ionscale.ACLNodeAttrGrant{
+			{Target: []string{"*"}, Attr: []string{"mullvad", "funnel"}},
+			{Target: []string{"tag:connector"}, App: tailcfg.NodeCapMap{
+				"example.com/cap/custom": []tailcfg.RawMessage{`{"key":"value"}`},
+			}},
+		},
+		AppConnectors: []ionscale.ACLAppConnector{
+			{Name: "github", Connectors: []string{"tag:connector"}, Domains: []string{"github.com"}},
+		},
+	}}
+
+	noRoutes := func(m *domain.Machine) []netip.Prefix { return nil }
+	serviceUser := &domain.User{ID: 3, Name: "service"}
+
+	connector, err := json.Marshal(appctype.AppConnectorAttr{Name: "github", Connectors: []string{"tag:connector"}, Domains: []string{"github.com"}})
+	require.NoError(t, err)
+
+	t.Run("capabilities before version 74", func(t *testing.T) {
+		n, _, err := ToNode(73, newToNodeMachine(tailnet, "tag:connector"), tailnet, policy, serviceUser, false, true, noRoutes)
+		require.NoError(t, err)
+
+		// only valueless capabilities are supported, funnel is never offered
+		require.Equal(t, []tailcfg.NodeCapability{"mullvad", tailcfg.CapabilityFileSharing}, n.Capabilities)
+		require.Nil(t, n.CapMap)
+	})
+
+	t.Run("cap map since version 74", func(t *testing.T) {
+		n, _, err := ToNode(74, newToNodeMachine(tailnet, "tag:connector"), tailnet, policy, serviceUser, false, true, noRoutes)
+		require.NoError(t, err)
+
+		require.Nil(t, n.Capabilities)
+		require.Equal(t, tailcfg.NodeCapMap{
+			"mullvad":                      []tailcfg.RawMessage{},
+			"example.com/cap/custom":       []tailcfg.RawMessage{`{"key":"value"}`},
+			domain.AppConnectorsCapability: []tailcfg.RawMessage{tailcfg.RawMessage(connector)},
+			tailcfg.CapabilityFileSharing:  []tailcfg.RawMessage{},
+		}, n.CapMap)
+	})
+
+	t.Run("app connectors merged with attributes of the same capability", func(t *testing.T) {
+		policy := &domain.ACLPolicy{ACLPolicy: ionscale.ACLPolicy{
+			NodeAttrs: []ionscale.ACLNodeAttrGrant{
+				{Target: []string{"tag:connector"}, App: tailcfg.NodeCapMap{
+					domain.AppConnectorsCapability: []tailcfg.RawMessage{`{"name":"custom"}`},
+				}},
+			},
+			AppConnectors: policy.AppConnectors,
+		}}
+
+		n, _, err := ToNode(74, newToNodeMachine(tailnet, "tag:connector"), tailnet, policy, serviceUser, false, true, noRoutes)
+		require.NoError(t, err)
+		require.Equal(t, []tailcfg.RawMessage{`{"name":"custom"}`, tailcfg.RawMessage(connector)}, n.CapMap[domain.AppConnectorsCapability])
+
+		n, _, err = ToNode(73, newToNodeMachine(tailnet, "tag:connector"), tailnet, policy, serviceUser, false, true, noRoutes)
+		require.NoError(t, err)
+		require.NotContains(t, n.Capabilities, tailcfg.NodeCapability(domain.AppConnectorsCapability))
+	})
+
+	t.Run("machines without matching attributes", func(t *testing.T) {
+		n, _, err := ToNode(74, newToNodeMachine(tailnet), tailnet, policy, serviceUser, false, true, noRoutes)
+		require.NoError(t, err)
+		require.Equal(t, tailcfg.NodeCapMap{
+			"mullvad":                     []tailcfg.RawMessage{},
+			tailcfg.CapabilityFileSharing: []tailcfg.RawMessage{},
+		}, n.CapMap)
+	})
+
+	t.Run("peers have no capabilities", func(t *testing.T) {
+		for _, capVer := range []tailcfg.CapabilityVersion{73, 74} {
+			n, _, err := ToNode(capVer, newToNodeMachine(tailnet, "tag:connector"), tailnet, nil, serviceUser, true, true, noRoutes)
+			require.NoError(t, err)
+			require.Nil(t, n.Capabilities)
+			require.Nil(t, n.CapMap)
+		}
+	})
+}
@@ -2,11 +2,15 @@ package mapping
 
 import (
 	"context"
+	"fmt"
 	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/derp"
 	"github.com/jsiebens/ionscale/internal/domain"
 	"net/netip"
+	"slices"
 	"sync"
 	"tailscale.com/tailcfg"
+	"tailscale.com/types/dnstype"
 	"tailscale.com/types/opt"
 	"time"
 )
@@ -17,9 +21,10 @@ import (
 type MapResponse struct {
 	tailcfg.MapResponse
 	PacketFilter []tailcfg.FilterRule
+	Health       []string
 }
 
-func NewPollNetMapper(req *tailcfg.MapRequest, machineID uint64, repository domain.Repository, sessionManager core.PollMapSessionManager) *PollNetMapper {
+func NewPollNetMapper(req *tailcfg.MapRequest, machineID uint64, repository domain.Repository, sessionManager core.PollMapSessionManager, derpProber *derp.Prober) *PollNetMapper {
 	return &PollNetMapper{
 		req:                 req,
 		machineID:           machineID,
@@ -27,6 +32,7 @@ func NewPollNetMapper(req *tailcfg.MapRequest, machineID uint64, repository doma
 		prevDerpMapChecksum: "",
 		repository:          repository,
 		sessionManager:      sessionManager,
+		derpProber:          derpProber,
 	}
 }
 
@@ -40,6 +46,7 @@ type PollNetMapper struct {
 
 	repository     domain.Repository
 	sessionManager core.PollMapSessionManager
+	derpProber     *derp.Prober
 }
 
 func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*MapResponse, error) {
@@ -53,9 +60,19 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 
 	hostinfo := tailcfg.Hostinfo(m.HostInfo)
 	tailnet := m.Tailnet
-	policies := tailnet.ACLPolicy.Get()
 	dnsConfig := tailnet.DNSConfig
 
+	policies, err := h.repository.GetACLPolicy(ctx, &tailnet)
+	if err != nil {
+		return nil, err
+	}
+
+	temporaryGrants, err := h.repository.ListActiveTemporaryGrants(ctx, tailnet.ID)
+	if err != nil {
+		return nil, err
+	}
+	policies.MergeTemporaryGrants(temporaryGrants)
+
 	serviceUser, _, err := h.repository.GetOrCreateServiceUser(ctx, &tailnet)
 	if err != nil {
 		return nil, err
@@ -65,10 +82,22 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 	if err != nil {
 		return nil, err
 	}
+	derpMap = h.derpProber.Deprioritize(derpMap)
+
+	candidatePeers, err := h.repository.ListMachinePeers(ctx, m.TailnetID, m.ID)
+	if err != nil {
+		return nil, err
+	}
 
-	prc := &primaryRoutesCollector{flagged: map[netip.Prefix]bool{}}
+	primaries := h.sessionManager.SelectPrimaryRoutes(m.TailnetID, slices.Concat(candidatePeers, domain.Machines{*m}))
+	isOnline := func(p *domain.Machine) bool { return h.sessionManager.HasSession(p.TailnetID, p.ID) }
+
+	prc := &primaryRoutesCollector{
+		primaries: primaries,
+		via:       policies.SelectViaRoutes(m, candidatePeers, primaries, isOnline),
+	}
 
-	node, user, err := ToNode(h.req.Version, m, &tailnet, serviceUser, false, true, prc.filter)
+	node, user, err := ToNode(h.req.Version, m, &tailnet, policies, serviceUser, false, true, prc.filter)
 	if err != nil {
 		return nil, err
 	}
@@ -78,25 +107,29 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 	var removedPeers []tailcfg.NodeID
 	var filterRules = make([]tailcfg.FilterRule, 0)
 	var sshPolicy *tailcfg.SSHPolicy
+	var validPeers []domain.Machine
 	syncedPeerIDs := map[uint64]bool{}
 
 	if !h.req.OmitPeers {
-		candidatePeers, err := h.repository.ListMachinePeers(ctx, m.TailnetID, m.ID)
-		if err != nil {
-			return nil, err
-		}
-
 		syncedUserIDs := map[tailcfg.UserID]bool{user.ID: true}
 
 		for _, peer := range candidatePeers {
 			if policies.IsValidPeer(m, &peer) || policies.IsValidPeer(&peer, m) {
 				isConnected := h.sessionManager.HasSession(peer.TailnetID, peer.ID)
 
-				n, u, err := ToNode(h.req.Version, &peer, &tailnet, serviceUser, true, isConnected, prc.filter)
+				// exit nodes are only offered when allowed by grants with via
+				router := peer
+				if !policies.IsExitNodeAllowedVia(m, &peer) {
+					router.AllowIPs = withoutExitRoutes(peer.AllowIPs)
+					router.AutoAllowIPs = withoutExitRoutes(peer.AutoAllowIPs)
+				}
+
+				n, u, err := ToNode(h.req.Version, &router, &tailnet, policies, serviceUser, true, isConnected, prc.filter)
 				if err != nil {
 					return nil, err
 				}
 				changedPeers = append(changedPeers, n)
+				validPeers = append(validPeers, peer)
 				syncedPeerIDs[peer.ID] = true
 				delete(h.prevSyncedPeerIDs, peer.ID)
 
@@ -107,13 +140,54 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 			}
 		}
 
+		sharees, err := h.repository.ListMachineSharees(ctx, m.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		sharedPeers, err := h.listSharedPeers(ctx, m, policies, sharees)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, peer := range sharedPeers {
+			if _, ok := syncedPeerIDs[peer.ID]; ok {
+				continue
+			}
+
+			serviceUser, _, err := h.repository.GetOrCreateServiceUser(ctx, &peer.Tailnet)
+			if err != nil {
+				return nil, err
+			}
+
+			isConnected := h.sessionManager.HasSession(peer.TailnetID, peer.ID)
+
+			// routes and exit nodes are not shared with other tailnets
+			shared := peer
+			shared.AllowIPs = nil
+			shared.AutoAllowIPs = nil
+
+			n, u, err := ToNode(h.req.Version, &shared, &peer.Tailnet, nil, serviceUser, true, isConnected, prc.filter)
+			if err != nil {
+				return nil, err
+			}
+			changedPeers = append(changedPeers, n)
+			syncedPeerIDs[peer.ID] = true
+			delete(h.prevSyncedPeerIDs, peer.ID)
+
+			if _, ok := syncedUserIDs[u.ID]; !ok {
+				users = append(users, *u)
+				syncedUserIDs[u.ID] = true
+			}
+		}
+
 		for p, _ := range h.prevSyncedPeerIDs {
 			removedPeers = append(removedPeers, tailcfg.NodeID(p))
 		}
 
-		filterRules = policies.BuildFilterRules(candidatePeers, m)
+		filterRules = policies.BuildFilterRules(slices.Concat(candidatePeers, sharees), m)
 
-		if tailnet.SSHEnabled && hostinfo.TailscaleSSHEnabled() {
+		if tailnet.SSHEnabled && hostinfo.TailscaleSSHEnabled() && !m.IsIsolated() {
 			sshPolicy = policies.BuildSSHPolicy(candidatePeers, m)
 		}
 	}
@@ -121,11 +195,19 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 	controlTime := time.Now().UTC()
 	var mapResponse tailcfg.MapResponse
 
+	tsDNSConfig := ToDNSConfig(m, &m.Tailnet, &dnsConfig)
+	for d, resolvers := range ToAppConnectorDNSRoutes(policies, validPeers) {
+		if tsDNSConfig.Routes == nil {
+			tsDNSConfig.Routes = make(map[string][]*dnstype.Resolver)
+		}
+		tsDNSConfig.Routes[d] = append(tsDNSConfig.Routes[d], resolvers...)
+	}
+
 	if !delta {
 		mapResponse = tailcfg.MapResponse{
 			KeepAlive:       false,
 			Node:            node,
-			DNSConfig:       ToDNSConfig(m, &m.Tailnet, &dnsConfig),
+			DNSConfig:       tsDNSConfig,
 			PacketFilter:    filterRules,
 			SSHPolicy:       sshPolicy,
 			DERPMap:         &derpMap.DERPMap,
@@ -141,7 +223,7 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 	} else {
 		mapResponse = tailcfg.MapResponse{
 			Node:            node,
-			DNSConfig:       ToDNSConfig(m, &m.Tailnet, &dnsConfig),
+			DNSConfig:       tsDNSConfig,
 			PacketFilter:    filterRules,
 			SSHPolicy:       sshPolicy,
 			Domain:          domain.SanitizeTailnetName(m.Tailnet.Name),
@@ -166,30 +248,89 @@ func (h *PollNetMapper) CreateMapResponse(ctx context.Context, delta bool) (*Map
 	h.prevSyncedPeerIDs = syncedPeerIDs
 	h.prevDerpMapChecksum = derpMap.Checksum
 
-	return &MapResponse{MapResponse: mapResponse, PacketFilter: filterRules}, nil
+	// an empty health list clears the message when a machine is no longer quarantined
+	health := []string{}
+	if m.Quarantined {
+		health = append(health, quarantineMessage(m))
+	}
+	if !m.HasTags() && m.User.Suspended {
+		health = append(health, "The owner of this machine is suspended, it can't connect to any peer until the user is reactivated.")
+	}
+
+	return &MapResponse{MapResponse: mapResponse, PacketFilter: filterRules, Health: health}, nil
+}
+
+func quarantineMessage(m *domain.Machine) string {
+	if m.QuarantineReason == "" {
+		return "This machine is quarantined by an administrator of the tailnet, it can't connect to any peer."
+	}
+	return fmt.Sprintf("This machine is quarantined by an administrator of the tailnet, it can't connect to any peer: %s", m.QuarantineReason)
+}
+
+// listSharedPeers lists the peers from other tailnets: the machines of the tailnets this machine is shared with,
+// when allowed by autogroup:shared in the policy of this tailnet, and the machines shared with this tailnet,
+// when allowed by autogroup:shared in the policy of the sharing tailnet.
+// Only the policy of the sharing tailnet is taken into account, so shared machines can't initiate connections.
+func (h *PollNetMapper) listSharedPeers(ctx context.Context, m *domain.Machine, policies *domain.ACLPolicy, sharees domain.Machines) ([]domain.Machine, error) {
+	var result []domain.Machine
+
+	for _, peer := range sharees {
+		if policies.IsValidPeer(&peer, m) {
+			result = append(result, peer)
+		}
+	}
+
+	sharedMachines, err := h.repository.ListSharedMachines(ctx, m.TailnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	sharee := *m
+	sharee.Sharee = true
+
+	var sharingPolicies = map[uint64]*domain.ACLPolicy{}
+	for _, peer := range sharedMachines {
+		p, ok := sharingPolicies[peer.TailnetID]
+		if !ok {
+			p, err = h.repository.GetACLPolicy(ctx, &peer.Tailnet)
+			if err != nil {
+				return nil, err
+			}
+			sharingPolicies[peer.TailnetID] = p
+		}
+
+		if p.IsValidPeer(&sharee, &peer) {
+			result = append(result, peer)
+		}
+	}
+
+	return result, nil
 }
 
 type primaryRoutesCollector struct {
-	flagged map[netip.Prefix]bool
+	primaries map[netip.Prefix]uint64
+	via       map[netip.Prefix]uint64
 }
 
 func (p *primaryRoutesCollector) filter(m *domain.Machine) []netip.Prefix {
 	var result []netip.Prefix
-	for _, r := range m.AllowIPs {
-		if _, ok := p.flagged[r]; r.Bits() != 0 && !ok {
-			result = append(result, r)
-			p.flagged[r] = true
+	for _, r := range slices.Concat(m.AllowIPs, m.AutoAllowIPs) {
+		id, ok := p.via[r]
+		if !ok {
+			id, ok = p.primaries[r]
 		}
-	}
-	for _, r := range m.AutoAllowIPs {
-		if _, ok := p.flagged[r]; r.Bits() != 0 && !ok {
+
+		if ok && id == m.ID && !slices.Contains(result, r) {
 			result = append(result, r)
-			p.flagged[r] = true
 		}
 	}
 	return result
 }
 
+func withoutExitRoutes(routes []netip.Prefix) []netip.Prefix {
+	return slices.DeleteFunc(slices.Clone(routes), func(r netip.Prefix) bool { return r.Bits() == 0 })
+}
+
 func optBool(v bool) opt.Bool {
 	b := opt.Bool("")
 	b.Set(v)
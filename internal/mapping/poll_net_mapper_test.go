@@ -0,0 +1,190 @@
+package mapping
+
+import (
+	"context"
+	"net/netip"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+)
+
+func newTestRepository(t *testing.T) domain.Repository {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+	return repository
+}
+
+func newTestTailnet(t *testing.T, repository domain.Repository, name string, policy ionscale.ACLPolicy) *domain.Tailnet {
+	tailnet := &domain.Tailnet{
+		ID:        util.NextID(),
+		Name:      name,
+		IAMPolicy: domain.NewHuJSON(&domain.IAMPolicy{}),
+		ACLPolicy: domain.NewHuJSON(&domain.ACLPolicy{ACLPolicy: policy}),
+	}
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+	return tailnet
+}
+
+func newTestMachine(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, name string, ipv4, ipv6 string) *domain.Machine {
+	user := &domain.User{ID: util.NextID(), Name: name + "@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(context.Background(), user))
+
+	v4, v6 := netip.MustParseAddr(ipv4), netip.MustParseAddr(ipv6)
+	m := &domain.Machine{
+		ID:         util.NextID(),
+		Name:       name,
+		NodeKey:    key.NewNode().Public().String(),
+		MachineKey: key.NewMachine().Public().String(),
+		TailnetID:  tailnet.ID,
+		UserID:     user.ID,
+		Authorized: true,
+		IPv4:       domain.IP{Addr: &v4},
+		IPv6:       domain.IP{Addr: &v6},
+		CreatedAt:  time.Now().UTC(),
+		ExpiresAt:  time.Now().UTC().Add(time.Hour),
+	}
+	require.NoError(t, repository.SaveMachine(context.Background(), m))
+	return m
+}
+
+func findPeer(peers []*tailcfg.Node, m *domain.Machine) *tailcfg.Node {
+	for _, p := range peers {
+		if p.ID == tailcfg.NodeID(m.ID) {
+			return p
+		}
+	}
+	return nil
+}
+
+func TestPollNetMapper_SharedPeers(t *testing.T) {
+	repository := newTestRepository(t)
+	sessionManager := core.NewPollMapSessionManager()
+	ctx := context.Background()
+
+	source := newTestTailnet(t, repository, "source", ionscale.ACLPolicy{
+		ACLs: []ionscale.ACLEntry{
+			{Action: "accept", Source: []string{"*"}, Destination: []string{"*:*"}},
+			{Action: "accept", Source: []string{"autogroup:shared"}, Destination: []string{"*:*"}},
+		},
+	})
+	receiver := newTestTailnet(t, repository, "receiver", ionscale.ACLPolicy{
+		ACLs: []ionscale.ACLEntry{
+			{Action: "accept", Source: []string{"*"}, Destination: []string{"*:*"}},
+		},
+	})
+
+	routes := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")}
+
+	web := newTestMachine(t, repository, source, "web", "100.64.0.1", "fd7a:115c:a1e0::1")
+	web.HostInfo.RoutableIPs = routes
+	web.AllowIPs = routes
+	require.NoError(t, repository.SaveMachine(ctx, web))
+
+	db := newTestMachine(t, repository, source, "db", "100.64.0.2", "fd7a:115c:a1e0::2")
+	laptop := newTestMachine(t, repository, receiver, "laptop", "100.64.0.3", "fd7a:115c:a1e0::3")
+
+	// the routes of web are primary and web is online
+	sessionManager.Register(source.ID, web.ID, make(chan *core.Ping, 20))
+
+	share := &domain.MachineShare{ID: util.NextID(), MachineID: web.ID, SourceTailnetID: source.ID, TailnetID: receiver.ID}
+	require.NoError(t, repository.SaveMachineShare(ctx, share))
+
+	laptopMapper := NewPollNetMapper(&tailcfg.MapRequest{Version: 74}, laptop.ID, repository, sessionManager, nil)
+	webMapper := NewPollNetMapper(&tailcfg.MapRequest{Version: 74}, web.ID, repository, sessionManager, nil)
+	dbMapper := NewPollNetMapper(&tailcfg.MapRequest{Version: 74}, db.ID, repository, sessionManager, nil)
+
+	// pending shares are not visible
+	resp, err := laptopMapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, resp.Peers)
+
+	share.Accepted = true
+	require.NoError(t, repository.SaveMachineShare(ctx, share))
+
+	resp, err = laptopMapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, resp.Peers, 1)
+
+	// routes and exit nodes are not shared with other tailnets
+	peer := findPeer(resp.Peers, web)
+	require.NotNil(t, peer)
+	require.Equal(t, []netip.Prefix{netip.MustParsePrefix("100.64.0.1/32"), netip.MustParsePrefix("fd7a:115c:a1e0::1/128")}, peer.AllowedIPs)
+	require.Empty(t, peer.PrimaryRoutes)
+
+	// within the source tailnet the routes are still available
+	resp, err = dbMapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	peer = findPeer(resp.Peers, web)
+	require.NotNil(t, peer)
+	require.Contains(t, peer.AllowedIPs, netip.MustParsePrefix("10.0.0.0/24"))
+	require.Contains(t, peer.AllowedIPs, netip.MustParsePrefix("0.0.0.0/0"))
+	require.Nil(t, findPeer(resp.Peers, laptop))
+
+	// the shared machine sees the machines of the receiving tailnet, allowed by autogroup:shared
+	resp, err = webMapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	require.NotNil(t, findPeer(resp.Peers, laptop))
+	require.NotNil(t, findPeer(resp.Peers, db))
+
+	// without autogroup:shared in the policy of the sharing tailnet, the machines of the tailnets don't see each other
+	source.ACLPolicy = domain.NewHuJSON(&domain.ACLPolicy{ACLPolicy: ionscale.ACLPolicy{
+		ACLs: []ionscale.ACLEntry{
+			{Action: "accept", Source: []string{"*"}, Destination: []string{"*:*"}},
+		},
+	}})
+	require.NoError(t, repository.SaveTailnet(ctx, source))
+
+	resp, err = laptopMapper.CreateMapResponse(ctx, true)
+	require.NoError(t, err)
+	require.Empty(t, resp.PeersChanged)
+	require.Equal(t, []tailcfg.NodeID{tailcfg.NodeID(web.ID)}, resp.PeersRemoved)
+
+	resp, err = webMapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	require.Nil(t, findPeer(resp.Peers, laptop))
+}
+
+func TestPollNetMapper_DeletedSharedMachine(t *testing.T) {
+	repository := newTestRepository(t)
+	sessionManager := core.NewPollMapSessionManager()
+	ctx := context.Background()
+
+	policy := ionscale.ACLPolicy{
+		ACLs: []ionscale.ACLEntry{
+			{Action: "accept", Source: []string{"autogroup:shared"}, Destination: []string{"*:*"}},
+		},
+	}
+
+	source := newTestTailnet(t, repository, "source", policy)
+	receiver := newTestTailnet(t, repository, "receiver", policy)
+
+	web := newTestMachine(t, repository, source, "web", "100.64.0.1", "fd7a:115c:a1e0::1")
+	laptop := newTestMachine(t, repository, receiver, "laptop", "100.64.0.2", "fd7a:115c:a1e0::2")
+
+	share := &domain.MachineShare{ID: util.NextID(), Accepted: true, MachineID: web.ID, SourceTailnetID: source.ID, TailnetID: receiver.ID}
+	require.NoError(t, repository.SaveMachineShare(ctx, share))
+
+	mapper := NewPollNetMapper(&tailcfg.MapRequest{Version: 74}, laptop.ID, repository, sessionManager, nil)
+
+	resp, err := mapper.CreateMapResponse(ctx, false)
+	require.NoError(t, err)
+	require.NotNil(t, findPeer(resp.Peers, web))
+
+	_, err = repository.DeleteMachine(ctx, web.ID)
+	require.NoError(t, err)
+
+	resp, err = mapper.CreateMapResponse(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, []tailcfg.NodeID{tailcfg.NodeID(web.ID)}, resp.PeersRemoved)
+}
@@ -84,7 +84,16 @@ func Start(ctx context.Context, c *config.Config) error {
 		return logError(err)
 	}
 
-	core.StartWorker(repository, sessionManager)
+	dnsProvider, err := dns.NewProvider(c.DNS)
+	if err != nil {
+		return logError(err)
+	}
+
+	core.StartWorker(repository, sessionManager, dnsProvider)
+	derp.StartRefresher(ctx, c, repository, sessionManager)
+
+	derpProber := derp.NewProber(c, repository, sessionManager)
+	derpProber.Start(ctx)
 
 	// prepare CertMagic
 	if c.Tls.AcmeEnabled {
@@ -107,22 +116,17 @@ func Start(ctx context.Context, c *config.Config) error {
 		}
 	}
 
-	authProvider, systemIAMPolicy, err := setupAuthProvider(c.Auth)
+	authProviders, systemIAMPolicy, err := setupAuthProviders(c.Auth)
 	if err != nil {
 		return logError(fmt.Errorf("error configuring OIDC provider: %v", err))
 	}
 
-	dnsProvider, err := dns.NewProvider(c.DNS)
-	if err != nil {
-		return logError(err)
-	}
-
 	promMiddleware := echoprometheus.NewMiddleware("http")
 
 	createPeerHandler := func(machinePublicKey key.MachinePublic) http.Handler {
 		registrationHandlers := handlers.NewRegistrationHandlers(machinePublicKey, c, sessionManager, repository)
-		pollNetMapHandler := handlers.NewPollNetMapHandler(machinePublicKey, sessionManager, repository)
-		dnsHandlers := handlers.NewDNSHandlers(machinePublicKey, dnsProvider)
+		pollNetMapHandler := handlers.NewPollNetMapHandler(machinePublicKey, sessionManager, repository, derpProber)
+		dnsHandlers := handlers.NewDNSHandlers(machinePublicKey, c, dnsProvider, repository)
 		idTokenHandlers := handlers.NewIDTokenHandlers(machinePublicKey, c, repository)
 		sshActionHandlers := handlers.NewSSHActionHandlers(machinePublicKey, c, repository)
 		queryFeatureHandlers := handlers.NewQueryFeatureHandlers(machinePublicKey, dnsProvider, repository)
@@ -149,12 +153,14 @@ func Start(ctx context.Context, c *config.Config) error {
 
 	authenticationHandlers := handlers.NewAuthenticationHandlers(
 		c,
-		authProvider,
+		authProviders,
 		systemIAMPolicy,
 		repository,
 	)
 
-	rpcService := service.NewService(c, authProvider, dnsProvider, repository, sessionManager)
+	scimHandlers := handlers.NewSCIMHandlers(repository, sessionManager)
+
+	rpcService := service.NewService(c, authProviders, dnsProvider, repository, sessionManager, derpProber)
 	rpcPath, rpcHandler := NewRpcHandler(serverKey.SystemAdminKey, repository, rpcService)
 
 	metricsMux := echo.New()
@@ -183,8 +189,70 @@ func Start(ctx context.Context, c *config.Config) error {
 	webMux.GET("/a/success", authenticationHandlers.Success, csrf)
 	webMux.GET("/a/error", authenticationHandlers.Error, csrf)
 
+	consoleHandlers := handlers.NewConsoleHandlers(c, repository, rpcService)
+	webMux.GET("/console/login", consoleHandlers.Login)
+	console := webMux.Group("/console", csrf, consoleHandlers.Middleware)
+	console.GET("", consoleHandlers.Index)
+	console.POST("/logout", consoleHandlers.Logout)
+	console.GET("/tailnets/:tid/machines", consoleHandlers.ListMachines)
+	console.GET("/tailnets/:tid/machines/:mid", consoleHandlers.GetMachine)
+	console.POST("/tailnets/:tid/machines/:mid/authorize", consoleHandlers.AuthorizeMachine)
+	console.POST("/tailnets/:tid/machines/:mid/routes", consoleHandlers.UpdateMachineRoutes)
+	console.GET("/tailnets/:tid/keys", consoleHandlers.ListAuthKeys)
+	console.POST("/tailnets/:tid/keys", consoleHandlers.CreateAuthKey)
+	console.POST("/tailnets/:tid/keys/:kid/delete", consoleHandlers.DeleteAuthKey)
+	console.GET("/tailnets/:tid/policies/:policy", consoleHandlers.GetPolicy)
+	console.POST("/tailnets/:tid/policies/:policy", consoleHandlers.SetPolicy)
+
+	scim := webMux.Group("/scim/v2", scimHandlers.Middleware)
+	scim.GET("/ServiceProviderConfig", scimHandlers.ServiceProviderConfig)
+	scim.GET("/Users", scimHandlers.ListUsers)
+	scim.POST("/Users", scimHandlers.CreateUser)
+	scim.GET("/Users/:id", scimHandlers.GetUser)
+	scim.PUT("/Users/:id", scimHandlers.ReplaceUser)
+	scim.PATCH("/Users/:id", scimHandlers.PatchUser)
+	scim.DELETE("/Users/:id", scimHandlers.DeleteUser)
+	scim.GET("/Groups", scimHandlers.ListGroups)
+	scim.POST("/Groups", scimHandlers.CreateGroup)
+	scim.GET("/Groups/:id", scimHandlers.GetGroup)
+	scim.PUT("/Groups/:id", scimHandlers.ReplaceGroup)
+	scim.PATCH("/Groups/:id", scimHandlers.PatchGroup)
+	scim.DELETE("/Groups/:id", scimHandlers.DeleteGroup)
+
+	tsApiHandlers := handlers.NewTailscaleAPIHandlers(repository, rpcService)
+	tsApi := webMux.Group("/api/v2", tsApiHandlers.Middleware)
+	tsApi.GET("/tailnet/:tailnet/devices", tsApiHandlers.ListDevices)
+	tsApi.GET("/device/:id", tsApiHandlers.GetDevice)
+	tsApi.DELETE("/device/:id", tsApiHandlers.DeleteDevice)
+	tsApi.POST("/device/:id/expire", tsApiHandlers.ExpireDevice)
+	tsApi.POST("/device/:id/authorized", tsApiHandlers.AuthorizeDevice)
+	tsApi.POST("/device/:id/key", tsApiHandlers.SetDeviceKey)
+	tsApi.POST("/device/:id/name", tsApiHandlers.SetDeviceName)
+	tsApi.GET("/device/:id/routes", tsApiHandlers.GetDeviceRoutes)
+	tsApi.POST("/device/:id/routes", tsApiHandlers.SetDeviceRoutes)
+	tsApi.GET("/tailnet/:tailnet/keys", tsApiHandlers.ListKeys)
+	tsApi.POST("/tailnet/:tailnet/keys", tsApiHandlers.CreateKey)
+	tsApi.GET("/tailnet/:tailnet/keys/:id", tsApiHandlers.GetKey)
+	tsApi.DELETE("/tailnet/:tailnet/keys/:id", tsApiHandlers.DeleteKey)
+	tsApi.GET("/tailnet/:tailnet/acl", tsApiHandlers.GetACL)
+	tsApi.POST("/tailnet/:tailnet/acl", tsApiHandlers.SetACL)
+	tsApi.GET("/tailnet/:tailnet/dns/nameservers", tsApiHandlers.GetNameservers)
+	tsApi.POST("/tailnet/:tailnet/dns/nameservers", tsApiHandlers.SetNameservers)
+	tsApi.GET("/tailnet/:tailnet/dns/searchpaths", tsApiHandlers.GetSearchPaths)
+	tsApi.POST("/tailnet/:tailnet/dns/searchpaths", tsApiHandlers.SetSearchPaths)
+	tsApi.GET("/tailnet/:tailnet/dns/preferences", tsApiHandlers.GetDNSPreferences)
+	tsApi.POST("/tailnet/:tailnet/dns/preferences", tsApiHandlers.SetDNSPreferences)
+
 	if !c.DERP.Server.Disabled {
-		derpHandlers := handlers.NewDERPHandler()
+		var trusted []key.NodePublic
+		if proberKey, ok := derpProber.PublicKey(); ok {
+			trusted = append(trusted, proberKey)
+		}
+
+		derpHandlers, err := handlers.NewDERPHandler(c, repository, trusted...)
+		if err != nil {
+			return logError(err)
+		}
 
 		metricsMux.GET("/debug/derp/traffic", derpHandlers.DebugTraffic)
 		metricsMux.GET("/debug/derp/check", derpHandlers.DebugCheck)
@@ -208,6 +276,11 @@ func Start(ctx context.Context, c *config.Config) error {
 		return logError(err)
 	}
 
+	dnsPC, dnsL, err := dnsListeners(c)
+	if err != nil {
+		return logError(err)
+	}
+
 	errorLog, err := zap.NewStdLogAt(logger, zap.DebugLevel)
 	if err != nil {
 		return logError(err)
@@ -216,6 +289,7 @@ func Start(ctx context.Context, c *config.Config) error {
 	webServer := &http.Server{ErrorLog: errorLog, Handler: h2c.NewHandler(webMux, &http2.Server{})}
 	metricsServer := &http.Server{ErrorLog: errorLog, Handler: metricsMux}
 	stunServer := stunserver.New(stunL)
+	dnsServer, _ := dnsProvider.(*dns.AuthoritativeServer)
 
 	g, gCtx := errgroup.WithContext(ctx)
 
@@ -226,11 +300,13 @@ func Start(ctx context.Context, c *config.Config) error {
 		shutdownHttpServer(metricsServer)
 		shutdownHttpServer(webServer)
 		_ = stunServer.Shutdown()
+		_ = dnsServer.Shutdown()
 	}()
 
 	g.Go(func() error { return serveHttp(webServer, webL) })
 	g.Go(func() error { return serveHttp(metricsServer, metricsL) })
 	g.Go(func() error { return stunServer.Serve() })
+	g.Go(func() error { return dnsServer.Serve(dnsPC, dnsL) })
 
 	fields := []zap.Field{
 		zap.String("url", c.PublicUrl.String()),
@@ -244,6 +320,10 @@ func Start(ctx context.Context, c *config.Config) error {
 		logger.Warn("Embedded DERP is disabled")
 	}
 
+	if dnsServer != nil {
+		fields = append(fields, zap.String("dns_addr", c.DNS.Server.ListenAddr), zap.String("dns_zone", dnsServer.Zone()))
+	}
+
 	if c.Tls.AcmeEnabled {
 		logger.Info("TLS is enabled with ACME", zap.String("domain", c.PublicUrl.Hostname()))
 		logger.Info("Server is running", fields...)
@@ -274,17 +354,21 @@ func shutdownHttpServer(s *http.Server) {
 	_ = s.Shutdown(ctx)
 }
 
-func setupAuthProvider(config config.Auth) (auth.Provider, *domain.IAMPolicy, error) {
-	if len(config.Provider.Issuer) == 0 {
-		return nil, &domain.IAMPolicy{}, nil
+func setupAuthProviders(config config.Auth) (auth.Providers, *domain.IAMPolicy, error) {
+	var authProviders = auth.Providers{}
+	for _, p := range config.AuthProviders() {
+		authProvider, err := auth.NewProvider(&p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to setup auth provider '%s': %w", p.Name, err)
+		}
+		authProviders = append(authProviders, authProvider)
 	}
 
-	authProvider, err := auth.NewOIDCProvider(&config.Provider)
-	if err != nil {
-		return nil, nil, err
+	if len(authProviders) == 0 {
+		return authProviders, &domain.IAMPolicy{}, nil
 	}
 
-	return authProvider, &domain.IAMPolicy{
+	return authProviders, &domain.IAMPolicy{
 		Subs:    config.SystemAdminPolicy.Subs,
 		Emails:  config.SystemAdminPolicy.Emails,
 		Filters: config.SystemAdminPolicy.Filters,
@@ -339,6 +423,25 @@ func stunListener(config *config.Config) (*net.UDPConn, error) {
 	return net.ListenUDP("udp", addr)
 }
 
+func dnsListeners(config *config.Config) (net.PacketConn, net.Listener, error) {
+	if config.DNS.Server.ListenAddr == "" {
+		return nil, nil, nil
+	}
+
+	pc, err := net.ListenPacket("udp", config.DNS.Server.ListenAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l, err := net.Listen("tcp", config.DNS.Server.ListenAddr)
+	if err != nil {
+		_ = pc.Close()
+		return nil, nil, err
+	}
+
+	return pc, l, nil
+}
+
 func setupLogging(config config.Logging) (*zap.Logger, error) {
 	level, err := zap.ParseAtomicLevel(config.Level)
 	if err != nil {
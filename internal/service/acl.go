@@ -10,7 +10,7 @@ import (
 
 func (s *Service) GetACLPolicy(ctx context.Context, req *connect.Request[api.GetACLPolicyRequest]) (*connect.Response[api.GetACLPolicyResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -27,7 +27,7 @@ func (s *Service) GetACLPolicy(ctx context.Context, req *connect.Request[api.Get
 
 func (s *Service) SetACLPolicy(ctx context.Context, req *connect.Request[api.SetACLPolicyRequest]) (*connect.Response[api.SetACLPolicyResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -45,15 +45,22 @@ func (s *Service) SetACLPolicy(ctx context.Context, req *connect.Request[api.Set
 	}
 
 	oldPolicy := tailnet.ACLPolicy
+	if req.Msg.PreviousHash != "" && req.Msg.PreviousHash != oldPolicy.Hash() {
+		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("acl policy was modified"))
+	}
+
 	if oldPolicy.Equal(newPolicy) {
 		return connect.NewResponse(&api.SetACLPolicyResponse{}), nil
 	}
 
-	tailnet.ACLPolicy = *newPolicy
-
-	if err := s.repository.SaveTailnet(ctx, tailnet); err != nil {
+	// the policy is only replaced when not modified since it was read, so a concurrent update is never lost silently
+	updated, err := s.repository.UpdateACLPolicy(ctx, tailnet.ID, &oldPolicy, newPolicy)
+	if err != nil {
 		return nil, logError(err)
 	}
+	if !updated {
+		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("acl policy was modified concurrently"))
+	}
 
 	s.sessionManager.NotifyAll(tailnet.ID)
 
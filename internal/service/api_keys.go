@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func apiKeyToApi(key *domain.ApiKey) *api.ApiKey {
+	var expiresAt *timestamppb.Timestamp
+	if key.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+
+	return &api.ApiKey{
+		Id:        key.ID,
+		Key:       key.Key,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+		ExpiresAt: expiresAt,
+		Tailnet: &api.Ref{
+			Id:   key.Tailnet.ID,
+			Name: key.Tailnet.Name,
+		},
+		User: &api.Ref{
+			Id:   key.User.ID,
+			Name: key.User.Name,
+		},
+	}
+}
+
+// ListApiKeys lists the api keys of the current user, including the keys created when logging in with the CLI.
+func (s *Service) ListApiKeys(ctx context.Context, _ *connect.Request[api.ListApiKeysRequest]) (*connect.Response[api.ListApiKeysResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if principal.User == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api keys are only available for users"))
+	}
+
+	apiKeys, err := s.repository.ListApiKeysByUser(ctx, principal.User.ID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	response := &api.ListApiKeysResponse{}
+	for _, key := range apiKeys {
+		response.ApiKeys = append(response.ApiKeys, apiKeyToApi(&key))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (s *Service) CreateApiKey(ctx context.Context, req *connect.Request[api.CreateApiKeyRequest]) (*connect.Response[api.CreateApiKeyResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if principal.User == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api keys are only available for users"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, principal.User.TailnetID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	expiry := s.config.Auth.ApiKeys.DefaultExpiry.Std()
+	if req.Msg.Expiry != nil {
+		expiry = req.Msg.Expiry.AsDuration()
+	}
+
+	if expiry <= 0 || expiry > s.config.Auth.ApiKeys.MaxExpiry.Std() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expiry must be positive and at most %s", s.config.Auth.ApiKeys.MaxExpiry.Std()))
+	}
+
+	expiresAt := time.Now().UTC().Add(expiry)
+	v, apiKey := domain.CreateApiKey(tailnet, principal.User, &expiresAt)
+
+	if err := s.repository.SaveApiKey(ctx, apiKey); err != nil {
+		return nil, logError(err)
+	}
+
+	apiKey.Tailnet = *tailnet
+	apiKey.User = *principal.User
+
+	return connect.NewResponse(&api.CreateApiKeyResponse{Value: v, ApiKey: apiKeyToApi(apiKey)}), nil
+}
+
+func (s *Service) DeleteApiKey(ctx context.Context, req *connect.Request[api.DeleteApiKeyRequest]) (*connect.Response[api.DeleteApiKeyResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	key, err := s.repository.GetApiKey(ctx, req.Msg.ApiKeyId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if key == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("api key not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.UserMatches(key.UserID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if _, err := s.repository.DeleteApiKey(ctx, req.Msg.ApiKeyId); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.DeleteApiKeyResponse{}), nil
+}
@@ -12,7 +12,7 @@ import (
 )
 
 func (s *Service) Authenticate(ctx context.Context, req *connect.Request[api.AuthenticateRequest], stream *connect.ServerStream[api.AuthenticateResponse]) error {
-	if s.authProvider == nil {
+	if len(s.authProviders) == 0 {
 		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no authentication method available, contact your ionscale administrator for more information"))
 	}
 
@@ -72,3 +72,47 @@ func (s *Service) Authenticate(ctx context.Context, req *connect.Request[api.Aut
 		}
 	}
 }
+
+func (s *Service) GetAuthProviders(ctx context.Context, req *connect.Request[api.GetAuthProvidersRequest]) (*connect.Response[api.GetAuthProvidersResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet does not exist"))
+	}
+
+	return connect.NewResponse(&api.GetAuthProvidersResponse{Providers: tailnet.AuthProviders, Available: s.authProviders.Names()}), nil
+}
+
+func (s *Service) SetAuthProviders(ctx context.Context, req *connect.Request[api.SetAuthProvidersRequest]) (*connect.Response[api.SetAuthProvidersResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet does not exist"))
+	}
+
+	if err := s.validateAuthProviders(req.Msg.Providers); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tailnet.AuthProviders = domain.SanitizeAuthProviders(req.Msg.Providers)
+
+	if err := s.repository.SaveTailnet(ctx, tailnet); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.SetAuthProvidersResponse{}), nil
+}
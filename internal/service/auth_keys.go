@@ -22,7 +22,7 @@ func (s *Service) GetAuthKey(ctx context.Context, req *connect.Request[api.GetAu
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("auth key not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(key.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(key.TailnetID) && !principal.UserMatches(key.UserID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -32,12 +32,13 @@ func (s *Service) GetAuthKey(ctx context.Context, req *connect.Request[api.GetAu
 	}
 
 	return connect.NewResponse(&api.GetAuthKeyResponse{AuthKey: &api.AuthKey{
-		Id:        key.ID,
-		Key:       key.Key,
-		Ephemeral: key.Ephemeral,
-		Tags:      key.Tags,
-		CreatedAt: timestamppb.New(key.CreatedAt),
-		ExpiresAt: expiresAt,
+		Id:          key.ID,
+		Key:         key.Key,
+		Ephemeral:   key.Ephemeral,
+		Tags:        key.Tags,
+		Description: key.Description,
+		CreatedAt:   timestamppb.New(key.CreatedAt),
+		ExpiresAt:   expiresAt,
 		Tailnet: &api.Ref{
 			Id:   key.Tailnet.ID,
 			Name: key.Tailnet.Name,
@@ -55,12 +56,13 @@ func mapAuthKeysToApi(authKeys []domain.AuthKey) []*api.AuthKey {
 		}
 
 		result = append(result, &api.AuthKey{
-			Id:        key.ID,
-			Key:       key.Key,
-			Ephemeral: key.Ephemeral,
-			Tags:      key.Tags,
-			CreatedAt: timestamppb.New(key.CreatedAt),
-			ExpiresAt: expiresAt,
+			Id:          key.ID,
+			Key:         key.Key,
+			Ephemeral:   key.Ephemeral,
+			Tags:        key.Tags,
+			Description: key.Description,
+			CreatedAt:   timestamppb.New(key.CreatedAt),
+			ExpiresAt:   expiresAt,
 			Tailnet: &api.Ref{
 				Id:   key.Tailnet.ID,
 				Name: key.Tailnet.Name,
@@ -73,7 +75,7 @@ func mapAuthKeysToApi(authKeys []domain.AuthKey) []*api.AuthKey {
 
 func (s *Service) ListAuthKeys(ctx context.Context, req *connect.Request[api.ListAuthKeysRequest]) (*connect.Response[api.ListAuthKeysResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetMember(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -113,7 +115,12 @@ func (s *Service) ListAuthKeys(ctx context.Context, req *connect.Request[api.Lis
 
 func (s *Service) CreateAuthKey(ctx context.Context, req *connect.Request[api.CreateAuthKeyRequest]) (*connect.Response[api.CreateAuthKeyResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetMember(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	// members can create auth keys for their own machines, but tagged or pre-authorized keys are reserved to admins
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(req.Msg.TailnetId) && (len(req.Msg.Tags) != 0 || req.Msg.PreAuthorized) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -135,7 +142,12 @@ func (s *Service) CreateAuthKey(ctx context.Context, req *connect.Request[api.Cr
 	}
 
 	if !principal.IsSystemAdmin() {
-		if err := tailnet.ACLPolicy.Get().CheckTagOwners(req.Msg.Tags, principal.User); err != nil {
+		policy, err := s.repository.GetACLPolicy(ctx, tailnet)
+		if err != nil {
+			return nil, logError(err)
+		}
+
+		if err := policy.CheckTagOwners(req.Msg.Tags, principal.User); err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
 	}
@@ -162,6 +174,7 @@ func (s *Service) CreateAuthKey(ctx context.Context, req *connect.Request[api.Cr
 	tags := domain.SanitizeTags(req.Msg.Tags)
 
 	v, authKey := domain.CreateAuthKey(tailnet, user, req.Msg.Ephemeral, req.Msg.PreAuthorized, tags, expiresAt)
+	authKey.Description = req.Msg.Description
 
 	if err := s.repository.SaveAuthKey(ctx, authKey); err != nil {
 		return nil, logError(err)
@@ -170,12 +183,13 @@ func (s *Service) CreateAuthKey(ctx context.Context, req *connect.Request[api.Cr
 	response := api.CreateAuthKeyResponse{
 		Value: v,
 		AuthKey: &api.AuthKey{
-			Id:        authKey.ID,
-			Key:       authKey.Key,
-			Ephemeral: authKey.Ephemeral,
-			Tags:      authKey.Tags,
-			CreatedAt: timestamppb.New(authKey.CreatedAt),
-			ExpiresAt: expiresAtPb,
+			Id:          authKey.ID,
+			Key:         authKey.Key,
+			Ephemeral:   authKey.Ephemeral,
+			Tags:        authKey.Tags,
+			Description: authKey.Description,
+			CreatedAt:   timestamppb.New(authKey.CreatedAt),
+			ExpiresAt:   expiresAtPb,
 			Tailnet: &api.Ref{
 				Id:   tailnet.ID,
 				Name: tailnet.Name,
@@ -197,7 +211,7 @@ func (s *Service) DeleteAuthKey(ctx context.Context, req *connect.Request[api.De
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("auth key not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(key.UserID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(key.TailnetID) && !principal.UserMatches(key.UserID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
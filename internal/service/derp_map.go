@@ -7,6 +7,7 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/jsiebens/ionscale/internal/domain"
 	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Service) GetDefaultDERPMap(ctx context.Context, _ *connect.Request[api.GetDefaultDERPMapRequest]) (*connect.Response[api.GetDefaultDERPMapResponse], error) {
@@ -24,3 +25,55 @@ func (s *Service) GetDefaultDERPMap(ctx context.Context, _ *connect.Request[api.
 
 	return connect.NewResponse(&api.GetDefaultDERPMapResponse{Value: raw}), nil
 }
+
+func (s *Service) GetDERPHealth(ctx context.Context, req *connect.Request[api.GetDERPHealthRequest]) (*connect.Response[api.GetDERPHealthResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && (req.Msg.TailnetId == 0 || !principal.IsTailnetAuditor(req.Msg.TailnetId)) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	dm := domain.GetDefaultDERPMap()
+	derpMap := &dm
+
+	if req.Msg.TailnetId != 0 {
+		tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+		if err != nil {
+			return nil, logError(err)
+		}
+		if tailnet == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+		}
+
+		derpMap, err = tailnet.GetDERPMap(ctx, dm)
+		if err != nil {
+			return nil, logError(err)
+		}
+	}
+
+	resp := &api.GetDERPHealthResponse{Enabled: s.derpProber != nil}
+
+	for _, regionID := range derpMap.DERPMap.RegionIDs() {
+		region := derpMap.DERPMap.Regions[regionID]
+		for _, n := range region.Nodes {
+			nh := &api.DERPNodeHealth{
+				RegionId:   int32(region.RegionID),
+				RegionCode: region.RegionCode,
+				NodeName:   n.Name,
+				HostName:   n.HostName,
+			}
+
+			if h, ok := s.derpProber.Health(n); ok {
+				nh.Probed = true
+				nh.Healthy = h.Healthy()
+				nh.DerpError = h.DERPError
+				nh.StunError = h.STUNError
+				nh.LatencyMs = h.Duration.Milliseconds()
+				nh.LastChecked = timestamppb.New(h.CheckedAt)
+			}
+
+			resp.Nodes = append(resp.Nodes, nh)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
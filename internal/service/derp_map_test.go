@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/derp"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func TestService_GetDERPHealth(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	other := newTestTailnet(t, repository, "other")
+
+	tailnet.DERPMap = domain.WrapDERPMap(tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{
+			900: {RegionID: 900, RegionCode: "custom", Nodes: []*tailcfg.DERPNode{
+				{Name: "900a", RegionID: 900, HostName: "derp.example.com"},
+			}},
+		},
+	})
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+
+	auditor := context.WithValue(context.Background(), principalKey, newTestPrincipal(t, repository, tailnet, "auditor@example.com", domain.UserRoleAuditor))
+	member := context.WithValue(context.Background(), principalKey, newTestPrincipal(t, repository, tailnet, "member@example.com", domain.UserRoleMember))
+
+	getHealth := func(ctx context.Context, tailnetID uint64) (*api.GetDERPHealthResponse, error) {
+		resp, err := s.GetDERPHealth(ctx, connect.NewRequest(&api.GetDERPHealthRequest{TailnetId: tailnetID}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	t.Run("auditors of the tailnet only", func(t *testing.T) {
+		_, err := getHealth(member, tailnet.ID)
+		require.True(t, isPermissionDenied(err))
+
+		_, err = getHealth(auditor, other.ID)
+		require.True(t, isPermissionDenied(err))
+
+		// the default DERP map is only available for system admins
+		_, err = getHealth(auditor, 0)
+		require.True(t, isPermissionDenied(err))
+
+		_, err = getHealth(auditor, tailnet.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("prober disabled", func(t *testing.T) {
+		resp, err := getHealth(auditor, tailnet.ID)
+		require.NoError(t, err)
+
+		require.False(t, resp.Enabled)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, int32(900), resp.Nodes[0].RegionId)
+		require.Equal(t, "900a", resp.Nodes[0].NodeName)
+		require.Equal(t, "derp.example.com", resp.Nodes[0].HostName)
+		require.False(t, resp.Nodes[0].Probed)
+	})
+
+	t.Run("prober enabled, nodes not probed yet", func(t *testing.T) {
+		c := &config.Config{DERP: config.DERP{Prober: config.DERPProber{Enabled: true}}}
+		s.derpProber = derp.NewProber(c, repository, s.sessionManager)
+		defer func() { s.derpProber = nil }()
+
+		resp, err := getHealth(auditor, tailnet.ID)
+		require.NoError(t, err)
+
+		require.True(t, resp.Enabled)
+		require.Len(t, resp.Nodes, 1)
+		require.False(t, resp.Nodes[0].Probed)
+	})
+}
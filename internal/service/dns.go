@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/bufbuild/connect-go"
+	"github.com/hashicorp/go-multierror"
 	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/domain"
 	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"strings"
+	"tailscale.com/util/dnsname"
 )
 
 func (s *Service) GetDNSConfig(ctx context.Context, req *connect.Request[api.GetDNSConfigRequest]) (*connect.Response[api.GetDNSConfigResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -32,7 +35,7 @@ func (s *Service) GetDNSConfig(ctx context.Context, req *connect.Request[api.Get
 
 func (s *Service) SetDNSConfig(ctx context.Context, req *connect.Request[api.SetDNSConfigRequest]) (*connect.Response[api.SetDNSConfigResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -46,6 +49,10 @@ func (s *Service) SetDNSConfig(ctx context.Context, req *connect.Request[api.Set
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("A DNS provider must be configured when enabling HTTPS Certs"))
 	}
 
+	if err := validateDNSRecords(dnsConfig.ExtraRecords); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
 	if err != nil {
 		return nil, logError(err)
@@ -54,6 +61,10 @@ func (s *Service) SetDNSConfig(ctx context.Context, req *connect.Request[api.Set
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
 	}
 
+	if err := s.validateDNSDomain(ctx, tailnet.ID, tailnet.Name, dnsConfig); err != nil {
+		return nil, err
+	}
+
 	oldConfig := tailnet.DNSConfig
 	newConfig := apiDNSConfigToDomainDNSConfig(req.Msg.Config)
 
@@ -71,6 +82,74 @@ func (s *Service) SetDNSConfig(ctx context.Context, req *connect.Request[api.Set
 	return connect.NewResponse(&api.SetDNSConfigResponse{Config: domainDNSConfigToApiDNSConfig(tailnet)}), nil
 }
 
+// validateDNSDomain checks if the custom domain of a tailnet is a valid name, not overlapping with the MagicDNS suffix
+// or the domain of another tailnet, and part of the zone of the DNS provider when one is configured.
+func (s *Service) validateDNSDomain(ctx context.Context, tailnetID uint64, tailnetName string, dnsConfig *api.DNSConfig) error {
+	name := normalizeDNSDomain(dnsConfig.Domain)
+
+	if name != "" {
+		if fqdn, err := dnsname.ToFQDN(name); err != nil || fqdn.NumLabels() < 2 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid dns domain '%s'", dnsConfig.Domain))
+		}
+
+		if domain.IsSubdomain(config.MagicDNSSuffix(), name) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dns domain '%s' overlaps with the MagicDNS suffix '%s'", name, config.MagicDNSSuffix()))
+		}
+
+		if s.dnsProvider != nil && !domain.IsSubdomain(name, s.dnsProvider.Zone()) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dns domain '%s' is not part of the zone '%s' of the DNS provider", name, s.dnsProvider.Zone()))
+		}
+	}
+
+	candidate := domain.Tailnet{Name: tailnetName, DNSConfig: domain.DNSConfig{Domain: name}}
+	tailnetDomain := candidate.DNSDomain(config.MagicDNSSuffix())
+
+	tailnets, err := s.repository.ListTailnets(ctx)
+	if err != nil {
+		return logError(err)
+	}
+
+	// the names of the machines of different tailnets must not overlap, so neither domain may contain the other
+	for _, t := range tailnets {
+		other := t.DNSDomain(config.MagicDNSSuffix())
+		if t.ID != tailnetID && (domain.IsSubdomain(tailnetDomain, other) || domain.IsSubdomain(other, tailnetDomain)) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dns domain '%s' overlaps with the domain of another tailnet", tailnetDomain))
+		}
+	}
+
+	return nil
+}
+
+func normalizeDNSDomain(name string) string {
+	return strings.ToLower(strings.Trim(name, "."))
+}
+
+func validateDNSRecords(records []*api.DNSRecord) error {
+	var mErr *multierror.Error
+	seen := map[string]bool{}
+	for _, r := range records {
+		record := apiDNSRecordToDomainDNSRecord(r)
+		if err := record.Validate(); err != nil {
+			mErr = multierror.Append(mErr, err)
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", strings.ToLower(strings.TrimSuffix(record.Name, ".")), record.Value)
+		if seen[key] {
+			mErr = multierror.Append(mErr, fmt.Errorf("duplicate dns record '%s' with value '%s'", record.Name, record.Value))
+		}
+		seen[key] = true
+	}
+	return mErr.ErrorOrNil()
+}
+
+func apiDNSRecordToDomainDNSRecord(record *api.DNSRecord) domain.DNSRecord {
+	return domain.DNSRecord{
+		Name:  record.Name,
+		Type:  strings.ToUpper(record.Type),
+		Value: record.Value,
+	}
+}
+
 func domainRoutesToApiRoutes(routes map[string][]string) map[string]*api.Routes {
 	var result = map[string]*api.Routes{}
 	for k, v := range routes {
@@ -93,25 +172,44 @@ func apiDNSConfigToDomainDNSConfig(dnsConfig *api.DNSConfig) domain.DNSConfig {
 	}
 
 	return domain.DNSConfig{
+		Domain:            normalizeDNSDomain(dnsConfig.Domain),
 		MagicDNS:          dnsConfig.MagicDns,
 		HttpsCertsEnabled: dnsConfig.HttpsCerts,
 		OverrideLocalDNS:  dnsConfig.OverrideLocalDns,
 		Nameservers:       dnsConfig.Nameservers,
 		Routes:            apiRoutesToDomainRoutes(dnsConfig.Routes),
 		SearchDomains:     dnsConfig.SearchDomains,
+		ExtraRecords:      apiDNSRecordsToDomainDNSRecords(dnsConfig.ExtraRecords),
 	}
 }
 
+func apiDNSRecordsToDomainDNSRecords(records []*api.DNSRecord) []domain.DNSRecord {
+	var result []domain.DNSRecord
+	for _, r := range records {
+		result = append(result, apiDNSRecordToDomainDNSRecord(r))
+	}
+	return result
+}
+
+func domainDNSRecordsToApiDNSRecords(records []domain.DNSRecord) []*api.DNSRecord {
+	var result []*api.DNSRecord
+	for _, r := range records {
+		result = append(result, &api.DNSRecord{Name: r.Name, Type: r.Type, Value: r.Value})
+	}
+	return result
+}
+
 func domainDNSConfigToApiDNSConfig(tailnet *domain.Tailnet) *api.DNSConfig {
-	tailnetDomain := domain.SanitizeTailnetName(tailnet.Name)
 	dnsConfig := tailnet.DNSConfig
 	return &api.DNSConfig{
+		Domain:           dnsConfig.Domain,
 		MagicDns:         dnsConfig.MagicDNS,
 		HttpsCerts:       dnsConfig.HttpsCertsEnabled,
-		MagicDnsSuffix:   fmt.Sprintf("%s.%s", tailnetDomain, config.MagicDNSSuffix()),
+		MagicDnsSuffix:   tailnet.DNSDomain(config.MagicDNSSuffix()),
 		OverrideLocalDns: dnsConfig.OverrideLocalDNS,
 		Nameservers:      dnsConfig.Nameservers,
 		Routes:           domainRoutesToApiRoutes(dnsConfig.Routes),
 		SearchDomains:    dnsConfig.SearchDomains,
+		ExtraRecords:     domainDNSRecordsToApiDNSRecords(dnsConfig.ExtraRecords),
 	}
 }
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/mapping"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func TestService_SetDNSConfig_ExtraRecords(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	principal := newTestPrincipal(t, repository, tailnet, "admin@example.com", domain.UserRoleNetworkAdmin)
+	ctx := context.WithValue(context.Background(), principalKey, principal)
+
+	invalid := [][]*api.DNSRecord{
+		{{Name: "", Value: "100.64.0.10"}},
+		{{Name: "db.internal", Value: "db.example.com"}},
+		{{Name: "db.internal", Type: "A", Value: "fd7a:115c:a1e0::10"}},
+		{{Name: "db.internal", Type: "AAAA", Value: "100.64.0.10"}},
+		{{Name: "db.internal", Type: "CNAME", Value: "100.64.0.10"}},
+		{{Name: "db.internal", Value: "100.64.0.10"}, {Name: "DB.internal.", Value: "100.64.0.10"}},
+	}
+
+	for _, records := range invalid {
+		_, err := s.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{
+			TailnetId: tailnet.ID,
+			Config:    &api.DNSConfig{MagicDns: true, ExtraRecords: records},
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+
+	resp, err := s.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{
+		TailnetId: tailnet.ID,
+		Config: &api.DNSConfig{
+			MagicDns: true,
+			ExtraRecords: []*api.DNSRecord{
+				{Name: "db.internal", Value: "100.64.0.10"},
+				{Name: "db.internal", Type: "aaaa", Value: "fd7a:115c:a1e0::10"},
+				{Name: "intranet.example.com", Type: "A", Value: "192.168.1.10"},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Config.ExtraRecords, 3)
+	require.Equal(t, "AAAA", resp.Msg.Config.ExtraRecords[1].Type)
+
+	tailnet, err = repository.GetTailnet(context.Background(), tailnet.ID)
+	require.NoError(t, err)
+
+	m := newTestMachine(t, repository, tailnet.ID, principal.User)
+	dnsConfig := mapping.ToDNSConfig(m, tailnet, &tailnet.DNSConfig)
+
+	require.Equal(t, []tailcfg.DNSRecord{
+		{Name: "db.internal", Value: "100.64.0.10"},
+		{Name: "db.internal", Type: "AAAA", Value: "fd7a:115c:a1e0::10"},
+		{Name: "intranet.example.com", Type: "A", Value: "192.168.1.10"},
+	}, dnsConfig.ExtraRecords)
+}
+
+type testDNSProvider struct {
+	zone string
+}
+
+func (p *testDNSProvider) Zone() string {
+	return p.zone
+}
+
+func (p *testDNSProvider) SetRecord(context.Context, string, string, string) error {
+	return nil
+}
+
+func (p *testDNSProvider) DeleteRecord(context.Context, string, string, string) error {
+	return nil
+}
+
+func TestService_SetDNSConfig_Domain(t *testing.T) {
+	s, repository := newTestService(t)
+	s.dnsProvider = &testDNSProvider{zone: "example.net."}
+
+	sales := newTestTailnet(t, repository, "sales")
+	tailnet := newTestTailnet(t, repository, "corp")
+	principal := newTestPrincipal(t, repository, tailnet, "admin@example.com", domain.UserRoleNetworkAdmin)
+	ctx := context.WithValue(context.Background(), principalKey, domain.Principal{SystemRole: domain.SystemRoleAdmin})
+
+	setDomain := func(tailnetID uint64, name string, httpsCerts bool) error {
+		_, err := s.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{
+			TailnetId: tailnetID,
+			Config:    &api.DNSConfig{MagicDns: true, HttpsCerts: httpsCerts, Domain: name},
+		}))
+		return err
+	}
+
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(tailnet.ID, "corp", false)))
+
+	// without a DNS provider, any domain not in use by another tailnet is accepted
+	s.dnsProvider = nil
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(tailnet.ID, "sales.ionscale.net", false)))
+	require.NoError(t, setDomain(tailnet.ID, "corp.example.com", false))
+	s.dnsProvider = &testDNSProvider{zone: "example.net."}
+
+	// with a DNS provider, the domain must be part of its zone
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(setDomain(tailnet.ID, "corp.example.com", true)))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(setDomain(tailnet.ID, "corp.example.com", false)))
+	require.NoError(t, setDomain(sales.ID, "Sales.Example.Net.", true))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(tailnet.ID, "sales.example.net", false)))
+	require.NoError(t, setDomain(tailnet.ID, "corp.example.net", false))
+
+	// domains nested in the domain of another tailnet, or in the MagicDNS suffix, are rejected
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(sales.ID, "a.corp.example.net", false)))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(sales.ID, "example.net", false)))
+	s.dnsProvider = nil
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(sales.ID, "ionscale.net", false)))
+	newTestTailnet(t, repository, "hr")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setDomain(sales.ID, "a.hr.ionscale.net", false)))
+	s.dnsProvider = &testDNSProvider{zone: "example.net."}
+	require.NoError(t, setDomain(sales.ID, "Sales.Example.Net.", true))
+
+	resp, err := s.GetDNSConfig(ctx, connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: sales.ID}))
+	require.NoError(t, err)
+	require.Equal(t, "sales.example.net", resp.Msg.Config.Domain)
+	require.Equal(t, "sales.example.net", resp.Msg.Config.MagicDnsSuffix)
+
+	tailnet, err = repository.GetTailnet(context.Background(), tailnet.ID)
+	require.NoError(t, err)
+
+	m := newTestMachine(t, repository, tailnet.ID, principal.User)
+	dnsConfig := mapping.ToDNSConfig(m, tailnet, &tailnet.DNSConfig)
+
+	require.Equal(t, []string{"corp.example.net"}, dnsConfig.Domains)
+	require.Contains(t, dnsConfig.ExitNodeFilteredSet, ".corp.example.net")
+}
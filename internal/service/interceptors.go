@@ -48,6 +48,15 @@ func AuthenticationInterceptor(systemAdminKey *key.ServerPrivate, repository dom
 	}
 }
 
+// ContextWithPrincipal authenticates the token like the AuthenticationInterceptor does for API calls,
+// so other entry points, e.g. the web console, can invoke the service with the same permissions.
+func ContextWithPrincipal(ctx context.Context, repository domain.Repository, token string) (context.Context, bool) {
+	if principal := exchangeToken(ctx, nil, repository, token); principal != nil {
+		return context.WithValue(ctx, principalKey, *principal), true
+	}
+	return ctx, false
+}
+
 func exchangeToken(ctx context.Context, systemAdminKey *key.ServerPrivate, repository domain.Repository, value string) *domain.Principal {
 	if len(value) == 0 {
 		return nil
@@ -61,7 +70,7 @@ func exchangeToken(ctx context.Context, systemAdminKey *key.ServerPrivate, repos
 	}
 
 	apiKey, err := repository.LoadApiKey(ctx, value)
-	if err == nil && apiKey != nil {
+	if err == nil && apiKey != nil && !apiKey.User.Suspended {
 		user := apiKey.User
 		tailnet := apiKey.Tailnet
 		role := tailnet.IAMPolicy.Get().GetRole(user)
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func inviteToApi(invite *domain.Invite) *api.Invite {
+	var expiresAt *timestamppb.Timestamp
+	if invite.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+
+	return &api.Invite{
+		Id:        invite.ID,
+		Key:       invite.Key,
+		Email:     invite.Email,
+		Role:      string(invite.Role),
+		MaxUses:   int32(invite.MaxUses),
+		Uses:      int32(invite.Uses),
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+		ExpiresAt: expiresAt,
+		Tailnet: &api.Ref{
+			Id:   invite.Tailnet.ID,
+			Name: invite.Tailnet.Name,
+		},
+	}
+}
+
+func (s *Service) CreateInvite(ctx context.Context, req *connect.Request[api.CreateInviteRequest]) (*connect.Response[api.CreateInviteResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	role := domain.UserRole(req.Msg.Role)
+	if role == domain.UserRoleNone {
+		role = domain.UserRoleMember
+	}
+
+	if !role.IsValid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role '%s'", role))
+	}
+
+	// granting a role other than member is reserved to admins, like changing the roles in the IAM policy
+	if role != domain.UserRoleMember && !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if req.Msg.MaxUses < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max uses must not be negative"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	var expiresAt *time.Time
+	if req.Msg.Expiry != nil {
+		e := time.Now().UTC().Add(req.Msg.Expiry.AsDuration())
+		expiresAt = &e
+	}
+
+	v, invite := domain.CreateInvite(tailnet, req.Msg.Email, role, int(req.Msg.MaxUses), expiresAt, principalName(principal))
+
+	if err := s.repository.SaveInvite(ctx, invite); err != nil {
+		return nil, logError(err)
+	}
+
+	invite.Tailnet = *tailnet
+
+	return connect.NewResponse(&api.CreateInviteResponse{
+		Invite: inviteToApi(invite),
+		Url:    s.config.CreateUrl("/a/i/%s", v),
+	}), nil
+}
+
+func (s *Service) ListInvites(ctx context.Context, req *connect.Request[api.ListInvitesRequest]) (*connect.Response[api.ListInvitesResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	invites, err := s.repository.ListInvites(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	response := &api.ListInvitesResponse{}
+	for _, invite := range invites {
+		response.Invites = append(response.Invites, inviteToApi(&invite))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// DeleteInvite revokes an invite link, users already admitted by the invite remain in the tailnet.
+func (s *Service) DeleteInvite(ctx context.Context, req *connect.Request[api.DeleteInviteRequest]) (*connect.Response[api.DeleteInviteResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	invite, err := s.repository.GetInvite(ctx, req.Msg.InviteId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if invite == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(invite.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if _, err := s.repository.DeleteInvite(ctx, req.Msg.InviteId); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.DeleteInviteResponse{}), nil
+}
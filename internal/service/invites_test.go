@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestService_CreateInvite(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	ctx := context.WithValue(context.Background(), principalKey, newTestPrincipal(t, repository, tailnet, "admin@example.com", domain.UserRoleAdmin))
+
+	_, err := s.CreateInvite(ctx, connect.NewRequest(&api.CreateInviteRequest{TailnetId: tailnet.ID, Role: "owner"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	resp, err := s.CreateInvite(ctx, connect.NewRequest(&api.CreateInviteRequest{
+		TailnetId: tailnet.ID,
+		Expiry:    durationpb.New(time.Hour),
+		MaxUses:   2,
+		Email:     "john@example.com",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, string(domain.UserRoleMember), resp.Msg.Invite.Role)
+
+	value := resp.Msg.Url[strings.LastIndex(resp.Msg.Url, "/")+1:]
+	require.True(t, strings.HasPrefix(resp.Msg.Url, "https://ionscale.example.com/a/i/"))
+
+	invite, err := repository.LoadInvite(context.Background(), value)
+	require.NoError(t, err)
+	require.NotNil(t, invite)
+	require.True(t, invite.IsUsable(time.Now().UTC()))
+	require.True(t, invite.Allows("John@example.com"))
+	require.False(t, invite.Allows("jane@example.com"))
+
+	for i := 0; i < 2; i++ {
+		claimed, err := repository.ClaimInvite(context.Background(), invite.ID)
+		require.NoError(t, err)
+		require.True(t, claimed)
+	}
+
+	claimed, err := repository.ClaimInvite(context.Background(), invite.ID)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	invite, err = repository.LoadInvite(context.Background(), value)
+	require.NoError(t, err)
+	require.False(t, invite.IsUsable(time.Now().UTC()))
+}
@@ -61,12 +61,14 @@ func (s *Service) machineToApi(m *domain.Machine) *api.Machine {
 		AdvertisedExitNode: m.IsAdvertisedExitNode(),
 		EnabledExitNode:    m.IsAllowedExitNode(),
 		Authorized:         m.Authorized,
+		Quarantined:        m.Quarantined,
+		QuarantineReason:   m.QuarantineReason,
 	}
 }
 
 func (s *Service) ListMachines(ctx context.Context, req *connect.Request[api.ListMachinesRequest]) (*connect.Response[api.ListMachinesResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetMember(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -83,8 +85,14 @@ func (s *Service) ListMachines(ctx context.Context, req *connect.Request[api.Lis
 		return nil, logError(err)
 	}
 
+	// members only see their own machines
+	ownMachinesOnly := !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId)
+
 	response := &api.ListMachinesResponse{}
 	for _, m := range machines {
+		if ownMachinesOnly && !principal.IsMachineOwner(&m) {
+			continue
+		}
 		response.Machines = append(response.Machines, s.machineToApi(&m))
 	}
 
@@ -103,7 +111,7 @@ func (s *Service) GetMachine(ctx context.Context, req *connect.Request[api.GetMa
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(m.TailnetID) && !principal.IsMachineOwner(m) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -122,15 +130,24 @@ func (s *Service) DeleteMachine(ctx context.Context, req *connect.Request[api.De
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) && !principal.IsMachineOwner(m) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
+	// tailnets receiving a share of the machine have to drop it as a peer as well
+	sharingTailnetIDs, err := s.repository.ListSharingTailnetIDs(ctx, m.TailnetID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
 	if _, err := s.repository.DeleteMachine(ctx, req.Msg.MachineId); err != nil {
 		return nil, logError(err)
 	}
 
 	s.sessionManager.NotifyAll(m.TailnetID)
+	for _, id := range sharingTailnetIDs {
+		s.sessionManager.NotifyAll(id)
+	}
 
 	return connect.NewResponse(&api.DeleteMachineResponse{}), nil
 }
@@ -147,7 +164,7 @@ func (s *Service) ExpireMachine(ctx context.Context, req *connect.Request[api.Ex
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) && !principal.IsMachineOwner(m) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -176,7 +193,7 @@ func (s *Service) SetMachineName(ctx context.Context, req *connect.Request[api.S
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) && !principal.IsMachineOwner(m) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -242,7 +259,7 @@ func (s *Service) AuthorizeMachine(ctx context.Context, req *connect.Request[api
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -258,6 +275,83 @@ func (s *Service) AuthorizeMachine(ctx context.Context, req *connect.Request[api
 	return connect.NewResponse(&api.AuthorizeMachineResponse{}), nil
 }
 
+// QuarantineMachine isolates a machine from all its peers, while keeping its registration for further investigation
+func (s *Service) QuarantineMachine(ctx context.Context, req *connect.Request[api.QuarantineMachineRequest]) (*connect.Response[api.QuarantineMachineResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	m, err := s.repository.GetMachine(ctx, req.Msg.MachineId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if m == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	m.Quarantined = true
+	m.QuarantineReason = req.Msg.Reason
+	if err := s.repository.SaveMachine(ctx, m); err != nil {
+		return nil, logError(err)
+	}
+
+	if err := s.notifyMachineChanged(ctx, m); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.QuarantineMachineResponse{}), nil
+}
+
+func (s *Service) UnquarantineMachine(ctx context.Context, req *connect.Request[api.UnquarantineMachineRequest]) (*connect.Response[api.UnquarantineMachineResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	m, err := s.repository.GetMachine(ctx, req.Msg.MachineId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if m == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if m.Quarantined {
+		m.Quarantined = false
+		m.QuarantineReason = ""
+		if err := s.repository.SaveMachine(ctx, m); err != nil {
+			return nil, logError(err)
+		}
+	}
+
+	if err := s.notifyMachineChanged(ctx, m); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.UnquarantineMachineResponse{}), nil
+}
+
+// notifyMachineChanged triggers a map update for the tailnet of the machine,
+// and for the tailnets receiving a share of the machine, as they have it as a peer as well
+func (s *Service) notifyMachineChanged(ctx context.Context, m *domain.Machine) error {
+	sharingTailnetIDs, err := s.repository.ListSharingTailnetIDs(ctx, m.TailnetID)
+	if err != nil {
+		return err
+	}
+
+	s.sessionManager.NotifyAll(m.TailnetID)
+	for _, id := range sharingTailnetIDs {
+		s.sessionManager.NotifyAll(id)
+	}
+
+	return nil
+}
+
 func (s *Service) GetMachineRoutes(ctx context.Context, req *connect.Request[api.GetMachineRoutesRequest]) (*connect.Response[api.GetMachineRoutesResponse], error) {
 	principal := CurrentPrincipal(ctx)
 
@@ -270,10 +364,32 @@ func (s *Service) GetMachineRoutes(ctx context.Context, req *connect.Request[api
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
+	machines, err := s.repository.ListMachineByTailnet(ctx, m.TailnetID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	var primaryRoutes []*api.PrimaryRoute
+	primaries := s.sessionManager.SelectPrimaryRoutes(m.TailnetID, machines)
+	for _, r := range m.AllowedPrefixes() {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			continue
+		}
+
+		if id, ok := primaries[prefix]; ok {
+			for _, p := range machines {
+				if p.ID == id {
+					primaryRoutes = append(primaryRoutes, &api.PrimaryRoute{Route: r, Machine: &api.Ref{Id: p.ID, Name: p.CompleteName()}})
+				}
+			}
+		}
+	}
+
 	response := api.GetMachineRoutesResponse{
 		MachineId: m.ID,
 		Routes: &api.MachineRoutes{
@@ -281,6 +397,7 @@ func (s *Service) GetMachineRoutes(ctx context.Context, req *connect.Request[api
 			EnabledRoutes:      m.AllowedPrefixes(),
 			AdvertisedExitNode: m.IsAdvertisedExitNode(),
 			EnabledExitNode:    m.IsAllowedExitNode(),
+			PrimaryRoutes:      primaryRoutes,
 		},
 	}
 
@@ -299,7 +416,7 @@ func (s *Service) EnableMachineRoutes(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -352,7 +469,7 @@ func (s *Service) DisableMachineRoutes(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -401,7 +518,7 @@ func (s *Service) EnableExitNode(ctx context.Context, req *connect.Request[api.E
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -448,7 +565,7 @@ func (s *Service) DisableExitNode(ctx context.Context, req *connect.Request[api.
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -499,7 +616,7 @@ func (s *Service) SetMachineKeyExpiry(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(m.TailnetID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
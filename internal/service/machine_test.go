@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_QuarantineMachine_NotifiesSharingTailnets(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	receiver := newTestTailnet(t, repository, "receiver")
+	unrelated := newTestTailnet(t, repository, "unrelated")
+
+	admin := newTestPrincipal(t, repository, tailnet, "admin@example.com", domain.UserRoleITAdmin)
+	ctx := context.WithValue(context.Background(), principalKey, admin)
+
+	m := newTestMachine(t, repository, tailnet.ID, admin.User)
+
+	share := &domain.MachineShare{ID: util.NextID(), Accepted: true, MachineID: m.ID, SourceTailnetID: tailnet.ID, TailnetID: receiver.ID, CreatedAt: time.Now().UTC()}
+	require.NoError(t, repository.SaveMachineShare(context.Background(), share))
+
+	notified := map[uint64]chan *core.Ping{}
+	for _, tailnetID := range []uint64{tailnet.ID, receiver.ID, unrelated.ID} {
+		ch := make(chan *core.Ping, 1)
+		s.sessionManager.Register(tailnetID, util.NextID(), ch)
+		notified[tailnetID] = ch
+	}
+
+	drain := func() map[uint64]int {
+		result := map[uint64]int{}
+		for id, ch := range notified {
+			result[id] = len(ch)
+			for len(ch) > 0 {
+				<-ch
+			}
+		}
+		return result
+	}
+
+	_, err := s.QuarantineMachine(ctx, connect.NewRequest(&api.QuarantineMachineRequest{MachineId: m.ID, Reason: "compromised"}))
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int{tailnet.ID: 1, receiver.ID: 1, unrelated.ID: 0}, drain())
+
+	_, err = s.UnquarantineMachine(ctx, connect.NewRequest(&api.UnquarantineMachineRequest{MachineId: m.ID}))
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int{tailnet.ID: 1, receiver.ID: 1, unrelated.ID: 0}, drain())
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/config"
+	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/database"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type permissionFixture struct {
+	tailnet *domain.Tailnet
+	other   *domain.Tailnet
+	user    *domain.User
+	admin   *domain.User
+	machine *domain.Machine
+	authKey *domain.AuthKey
+	share   *domain.MachineShare
+	grant   *domain.TemporaryGrant
+	invite  *domain.Invite
+}
+
+func TestService_PermissionMatrix(t *testing.T) {
+	ctx := context.Background()
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	other := newTestTailnet(t, repository, "other")
+
+	principals := map[domain.UserRole]domain.Principal{}
+	for _, role := range []domain.UserRole{domain.UserRoleMember, domain.UserRoleAuditor, domain.UserRoleNetworkAdmin, domain.UserRoleITAdmin, domain.UserRoleAdmin} {
+		principals[role] = newTestPrincipal(t, repository, tailnet, string(role)+"@example.com", role)
+	}
+
+	newMachine := func(t *testing.T, tailnetID uint64, user *domain.User) *domain.Machine {
+		return newTestMachine(t, repository, tailnetID, user)
+	}
+
+	newFixture := func(t *testing.T) *permissionFixture {
+		user := &domain.User{ID: util.NextID(), Name: "john@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+		require.NoError(t, repository.SaveUser(ctx, user))
+
+		admin := &domain.User{ID: util.NextID(), Name: "jack@example.com", UserType: domain.UserTypePerson, TailnetID: tailnet.ID, InviteRole: domain.UserRoleAdmin}
+		require.NoError(t, repository.SaveUser(ctx, admin))
+
+		otherUser := &domain.User{ID: util.NextID(), Name: "jane@example.com", UserType: domain.UserTypePerson, TailnetID: other.ID}
+		require.NoError(t, repository.SaveUser(ctx, otherUser))
+
+		authKeyID := util.NextID()
+		authKey := &domain.AuthKey{ID: authKeyID, Key: fmt.Sprintf("key-%d", authKeyID), Hash: fmt.Sprintf("hash-%d", authKeyID), TailnetID: tailnet.ID, UserID: user.ID, CreatedAt: time.Now().UTC()}
+		require.NoError(t, repository.SaveAuthKey(ctx, authKey))
+
+		share := &domain.MachineShare{ID: util.NextID(), MachineID: newMachine(t, other.ID, otherUser).ID, SourceTailnetID: other.ID, TailnetID: tailnet.ID, CreatedAt: time.Now().UTC()}
+		require.NoError(t, repository.SaveMachineShare(ctx, share))
+
+		grant := &domain.TemporaryGrant{ID: util.NextID(), TailnetID: tailnet.ID, Source: "group:ops", Destination: "*", Ports: "*", Duration: time.Hour, Reason: "test", State: domain.TemporaryGrantPending, RequestedAt: time.Now().UTC()}
+		require.NoError(t, repository.SaveTemporaryGrant(ctx, grant))
+
+		_, invite := domain.CreateInvite(tailnet, "", domain.UserRoleMember, 1, nil, "test")
+		require.NoError(t, repository.SaveInvite(ctx, invite))
+
+		return &permissionFixture{
+			tailnet: tailnet,
+			other:   other,
+			user:    user,
+			admin:   admin,
+			machine: newMachine(t, tailnet.ID, user),
+			authKey: authKey,
+			share:   share,
+			grant:   grant,
+			invite:  invite,
+		}
+	}
+
+	auditor := []domain.UserRole{domain.UserRoleAuditor, domain.UserRoleNetworkAdmin, domain.UserRoleITAdmin, domain.UserRoleAdmin}
+	networkAdmin := []domain.UserRole{domain.UserRoleNetworkAdmin, domain.UserRoleAdmin}
+	itAdmin := []domain.UserRole{domain.UserRoleITAdmin, domain.UserRoleAdmin}
+	admin := []domain.UserRole{domain.UserRoleAdmin}
+	member := []domain.UserRole{domain.UserRoleMember, domain.UserRoleAuditor, domain.UserRoleNetworkAdmin, domain.UserRoleITAdmin, domain.UserRoleAdmin}
+
+	tests := []struct {
+		rpc     string
+		allowed []domain.UserRole
+		call    func(ctx context.Context, f *permissionFixture) error
+	}{
+		{"GetACLPolicy", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetACLPolicy(ctx, connect.NewRequest(&api.GetACLPolicyRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"SetACLPolicy", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetACLPolicy(ctx, connect.NewRequest(&api.SetACLPolicyRequest{TailnetId: f.tailnet.ID, Policy: "{}"}))
+			return err
+		}},
+		{"GetAuthProviders", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetAuthProviders(ctx, connect.NewRequest(&api.GetAuthProvidersRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"SetAuthProviders", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetAuthProviders(ctx, connect.NewRequest(&api.SetAuthProvidersRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"GetAuthKey", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetAuthKey(ctx, connect.NewRequest(&api.GetAuthKeyRequest{AuthKeyId: f.authKey.ID}))
+			return err
+		}},
+		{"ListAuthKeys", member, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListAuthKeys(ctx, connect.NewRequest(&api.ListAuthKeysRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"CreateAuthKey", member, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.CreateAuthKey(ctx, connect.NewRequest(&api.CreateAuthKeyRequest{TailnetId: f.tailnet.ID, Expiry: durationpb.New(time.Hour)}))
+			return err
+		}},
+		{"CreateAuthKey (pre-authorized)", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.CreateAuthKey(ctx, connect.NewRequest(&api.CreateAuthKeyRequest{TailnetId: f.tailnet.ID, Expiry: durationpb.New(time.Hour), PreAuthorized: true}))
+			return err
+		}},
+		{"DeleteAuthKey", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DeleteAuthKey(ctx, connect.NewRequest(&api.DeleteAuthKeyRequest{AuthKeyId: f.authKey.ID}))
+			return err
+		}},
+		{"GetDNSConfig", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetDNSConfig(ctx, connect.NewRequest(&api.GetDNSConfigRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"SetDNSConfig", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetDNSConfig(ctx, connect.NewRequest(&api.SetDNSConfigRequest{TailnetId: f.tailnet.ID, Config: &api.DNSConfig{}}))
+			return err
+		}},
+		{"GetIAMPolicy", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetIAMPolicy(ctx, connect.NewRequest(&api.GetIAMPolicyRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"SetIAMPolicy", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetIAMPolicy(ctx, connect.NewRequest(&api.SetIAMPolicyRequest{TailnetId: f.tailnet.ID, Policy: "{}"}))
+			return err
+		}},
+		{"ListMachines", member, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListMachines(ctx, connect.NewRequest(&api.ListMachinesRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"GetMachine", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetMachine(ctx, connect.NewRequest(&api.GetMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"DeleteMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DeleteMachine(ctx, connect.NewRequest(&api.DeleteMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"ExpireMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ExpireMachine(ctx, connect.NewRequest(&api.ExpireMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"SetMachineName", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetMachineName(ctx, connect.NewRequest(&api.SetMachineNameRequest{MachineId: f.machine.ID, Name: "renamed"}))
+			return err
+		}},
+		{"AuthorizeMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.AuthorizeMachine(ctx, connect.NewRequest(&api.AuthorizeMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"QuarantineMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.QuarantineMachine(ctx, connect.NewRequest(&api.QuarantineMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"UnquarantineMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.UnquarantineMachine(ctx, connect.NewRequest(&api.UnquarantineMachineRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"SetMachineKeyExpiry", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetMachineKeyExpiry(ctx, connect.NewRequest(&api.SetMachineKeyExpiryRequest{MachineId: f.machine.ID, Disabled: true}))
+			return err
+		}},
+		{"GetMachineRoutes", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetMachineRoutes(ctx, connect.NewRequest(&api.GetMachineRoutesRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"EnableMachineRoutes", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableMachineRoutes(ctx, connect.NewRequest(&api.EnableMachineRoutesRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"DisableMachineRoutes", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableMachineRoutes(ctx, connect.NewRequest(&api.DisableMachineRoutesRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"EnableExitNode", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableExitNode(ctx, connect.NewRequest(&api.EnableExitNodeRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"DisableExitNode", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableExitNode(ctx, connect.NewRequest(&api.DisableExitNodeRequest{MachineId: f.machine.ID}))
+			return err
+		}},
+		{"EnableSCIM", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableSCIM(ctx, connect.NewRequest(&api.EnableSCIMRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DisableSCIM", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableSCIM(ctx, connect.NewRequest(&api.DisableSCIMRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"ShareMachine", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ShareMachine(ctx, connect.NewRequest(&api.ShareMachineRequest{MachineId: f.machine.ID, Tailnet: f.other.Name}))
+			return err
+		}},
+		{"ListMachineShares", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListMachineShares(ctx, connect.NewRequest(&api.ListMachineSharesRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"AcceptMachineShare", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.AcceptMachineShare(ctx, connect.NewRequest(&api.AcceptMachineShareRequest{ShareId: f.share.ID}))
+			return err
+		}},
+		{"RevokeMachineShare", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.RevokeMachineShare(ctx, connect.NewRequest(&api.RevokeMachineShareRequest{ShareId: f.share.ID}))
+			return err
+		}},
+		{"UpdateTailnet", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.UpdateTailnet(ctx, connect.NewRequest(&api.UpdateTailnetRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"GetTailnet", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetTailnet(ctx, connect.NewRequest(&api.GetTailnetRequest{Id: f.tailnet.ID}))
+			return err
+		}},
+		{"SetDERPMap", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SetDERPMap(ctx, connect.NewRequest(&api.SetDERPMapRequest{TailnetId: f.tailnet.ID, Value: []byte("{}")}))
+			return err
+		}},
+		{"ResetDERPMap", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ResetDERPMap(ctx, connect.NewRequest(&api.ResetDERPMapRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"GetDERPMap", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetDERPMap(ctx, connect.NewRequest(&api.GetDERPMapRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"GetDERPHealth", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.GetDERPHealth(ctx, connect.NewRequest(&api.GetDERPHealthRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"EnableFileSharing", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableFileSharing(ctx, connect.NewRequest(&api.EnableFileSharingRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DisableFileSharing", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableFileSharing(ctx, connect.NewRequest(&api.DisableFileSharingRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"EnableServiceCollection", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableServiceCollection(ctx, connect.NewRequest(&api.EnableServiceCollectionRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DisableServiceCollection", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableServiceCollection(ctx, connect.NewRequest(&api.DisableServiceCollectionRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"EnableSSH", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableSSH(ctx, connect.NewRequest(&api.EnableSSHRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DisableSSH", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableSSH(ctx, connect.NewRequest(&api.DisableSSHRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"EnableMachineAuthorization", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.EnableMachineAuthorization(ctx, connect.NewRequest(&api.EnableMachineAuthorizationRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DisableMachineAuthorization", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DisableMachineAuthorization(ctx, connect.NewRequest(&api.DisableMachineAuthorizationRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"RequestTemporaryGrant", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.RequestTemporaryGrant(ctx, connect.NewRequest(&api.RequestTemporaryGrantRequest{TailnetId: f.tailnet.ID, Src: "group:ops", Dst: "*", Duration: durationpb.New(time.Hour), Reason: "test"}))
+			return err
+		}},
+		{"ApproveTemporaryGrant", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ApproveTemporaryGrant(ctx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: f.grant.ID}))
+			return err
+		}},
+		{"RevokeTemporaryGrant", networkAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.RevokeTemporaryGrant(ctx, connect.NewRequest(&api.RevokeTemporaryGrantRequest{GrantId: f.grant.ID}))
+			return err
+		}},
+		{"ListTemporaryGrants", member, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListTemporaryGrants(ctx, connect.NewRequest(&api.ListTemporaryGrantsRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"CreateInvite", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.CreateInvite(ctx, connect.NewRequest(&api.CreateInviteRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"CreateInvite (admin role)", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.CreateInvite(ctx, connect.NewRequest(&api.CreateInviteRequest{TailnetId: f.tailnet.ID, Role: string(domain.UserRoleAdmin)}))
+			return err
+		}},
+		{"ListInvites", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListInvites(ctx, connect.NewRequest(&api.ListInvitesRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DeleteInvite", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DeleteInvite(ctx, connect.NewRequest(&api.DeleteInviteRequest{InviteId: f.invite.ID}))
+			return err
+		}},
+		{"ListUsers", auditor, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ListUsers(ctx, connect.NewRequest(&api.ListUsersRequest{TailnetId: f.tailnet.ID}))
+			return err
+		}},
+		{"DeleteUser", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DeleteUser(ctx, connect.NewRequest(&api.DeleteUserRequest{UserId: f.user.ID}))
+			return err
+		}},
+		{"SuspendUser", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SuspendUser(ctx, connect.NewRequest(&api.SuspendUserRequest{UserId: f.user.ID}))
+			return err
+		}},
+		{"ReactivateUser", itAdmin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ReactivateUser(ctx, connect.NewRequest(&api.ReactivateUserRequest{UserId: f.user.ID}))
+			return err
+		}},
+		{"DeleteUser (admin)", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.DeleteUser(ctx, connect.NewRequest(&api.DeleteUserRequest{UserId: f.admin.ID}))
+			return err
+		}},
+		{"SuspendUser (admin)", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.SuspendUser(ctx, connect.NewRequest(&api.SuspendUserRequest{UserId: f.admin.ID}))
+			return err
+		}},
+		{"ReactivateUser (admin)", admin, func(ctx context.Context, f *permissionFixture) error {
+			_, err := s.ReactivateUser(ctx, connect.NewRequest(&api.ReactivateUserRequest{UserId: f.admin.ID}))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rpc, func(t *testing.T) {
+			f := newFixture(t)
+
+			// denied roles are checked first, as calls of allowed roles might remove the resources of the fixture
+			roles := []domain.UserRole{domain.UserRoleMember, domain.UserRoleAuditor, domain.UserRoleNetworkAdmin, domain.UserRoleITAdmin, domain.UserRoleAdmin}
+			for _, role := range roles {
+				if !containsRole(tt.allowed, role) {
+					err := tt.call(context.WithValue(ctx, principalKey, principals[role]), f)
+					require.Truef(t, isPermissionDenied(err), "expected %s to be denied for role %s, got %v", tt.rpc, role, err)
+				}
+			}
+			for _, role := range roles {
+				if containsRole(tt.allowed, role) {
+					err := tt.call(context.WithValue(ctx, principalKey, principals[role]), f)
+					require.Falsef(t, isPermissionDenied(err), "expected %s to be allowed for role %s, got %v", tt.rpc, role, err)
+				}
+			}
+		})
+	}
+}
+
+func newTestService(t *testing.T) (*Service, domain.Repository) {
+	_, repository, err := database.OpenDB(&config.Database{Type: "sqlite", Url: filepath.Join(t.TempDir(), "ionscale.db")}, zap.NewNop())
+	require.NoError(t, err)
+
+	c := &config.Config{
+		PublicUrl: &url.URL{Scheme: "https", Host: "ionscale.example.com"},
+		Auth:      config.Auth{ApiKeys: config.ApiKeys{DefaultExpiry: config.Duration(24 * time.Hour), MaxExpiry: config.Duration(90 * 24 * time.Hour)}},
+	}
+	return NewService(c, nil, nil, repository, core.NewPollMapSessionManager(), nil), repository
+}
+
+func newTestTailnet(t *testing.T, repository domain.Repository, name string) *domain.Tailnet {
+	tailnet := &domain.Tailnet{
+		ID:        util.NextID(),
+		Name:      name,
+		IAMPolicy: domain.NewHuJSON(&domain.IAMPolicy{}),
+		ACLPolicy: domain.NewHuJSON(&domain.ACLPolicy{}),
+	}
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+	return tailnet
+}
+
+func newTestPrincipal(t *testing.T, repository domain.Repository, tailnet *domain.Tailnet, name string, role domain.UserRole) domain.Principal {
+	u := &domain.User{ID: util.NextID(), Name: name, UserType: domain.UserTypePerson, TailnetID: tailnet.ID}
+	require.NoError(t, repository.SaveUser(context.Background(), u))
+	return domain.Principal{User: u, UserRole: role, SystemRole: domain.SystemRoleNone}
+}
+
+func newTestMachine(t *testing.T, repository domain.Repository, tailnetID uint64, user *domain.User, tags ...string) *domain.Machine {
+	ipv4, ipv6 := netip.MustParseAddr("100.64.0.1"), netip.MustParseAddr("fd7a:115c:a1e0::1")
+	id := util.NextID()
+	m := &domain.Machine{
+		ID:        id,
+		Name:      fmt.Sprintf("machine-%d", id),
+		TailnetID: tailnetID,
+		UserID:    user.ID,
+		Tags:      tags,
+		IPv4:      domain.IP{Addr: &ipv4},
+		IPv6:      domain.IP{Addr: &ipv6},
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+	}
+	require.NoError(t, repository.SaveMachine(context.Background(), m))
+	return m
+}
+
+func containsRole(roles []domain.UserRole, role domain.UserRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func isPermissionDenied(err error) bool {
+	var connectErr *connect.Error
+	return errors.As(err, &connectErr) && connectErr.Code() == connect.CodePermissionDenied
+}
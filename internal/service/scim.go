@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+)
+
+func (s *Service) EnableSCIM(ctx context.Context, req *connect.Request[api.EnableSCIMRequest]) (*connect.Response[api.EnableSCIMResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	value, token := domain.CreateSCIMToken(tailnet)
+
+	// only a single token is active at any time, enabling SCIM again rotates the token
+	err = s.repository.Transaction(func(tx domain.Repository) error {
+		if err := tx.DeleteSCIMTokensByTailnet(ctx, tailnet.ID); err != nil {
+			return err
+		}
+		if err := tx.SaveSCIMToken(ctx, token); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.EnableSCIMResponse{Url: s.config.CreateUrl("/scim/v2"), Token: value}), nil
+}
+
+func (s *Service) DisableSCIM(ctx context.Context, req *connect.Request[api.DisableSCIMRequest]) (*connect.Response[api.DisableSCIMResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	if err := s.repository.DeleteSCIMTokensByTailnet(ctx, tailnet.ID); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.DisableSCIMResponse{}), nil
+}
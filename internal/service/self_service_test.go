@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestService_MemberManagesOwnMachines(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+
+	john := newTestPrincipal(t, repository, tailnet, "john@example.com", domain.UserRoleMember)
+	jane := newTestPrincipal(t, repository, tailnet, "jane@example.com", domain.UserRoleMember)
+
+	own := newTestMachine(t, repository, tailnet.ID, john.User)
+	tagged := newTestMachine(t, repository, tailnet.ID, john.User, "tag:server")
+	other := newTestMachine(t, repository, tailnet.ID, jane.User)
+
+	ctx := context.WithValue(context.Background(), principalKey, john)
+
+	machines, err := s.ListMachines(ctx, connect.NewRequest(&api.ListMachinesRequest{TailnetId: tailnet.ID}))
+	require.NoError(t, err)
+	require.Len(t, machines.Msg.Machines, 1)
+	require.Equal(t, own.ID, machines.Msg.Machines[0].Id)
+
+	_, err = s.SetMachineName(ctx, connect.NewRequest(&api.SetMachineNameRequest{MachineId: own.ID, Name: "laptop"}))
+	require.NoError(t, err)
+
+	_, err = s.ExpireMachine(ctx, connect.NewRequest(&api.ExpireMachineRequest{MachineId: own.ID}))
+	require.NoError(t, err)
+
+	for _, m := range []*domain.Machine{tagged, other} {
+		_, err = s.GetMachine(ctx, connect.NewRequest(&api.GetMachineRequest{MachineId: m.ID}))
+		require.True(t, isPermissionDenied(err))
+
+		_, err = s.SetMachineName(ctx, connect.NewRequest(&api.SetMachineNameRequest{MachineId: m.ID, Name: "renamed"}))
+		require.True(t, isPermissionDenied(err))
+
+		_, err = s.ExpireMachine(ctx, connect.NewRequest(&api.ExpireMachineRequest{MachineId: m.ID}))
+		require.True(t, isPermissionDenied(err))
+
+		_, err = s.DeleteMachine(ctx, connect.NewRequest(&api.DeleteMachineRequest{MachineId: m.ID}))
+		require.True(t, isPermissionDenied(err))
+	}
+
+	_, err = s.DeleteMachine(ctx, connect.NewRequest(&api.DeleteMachineRequest{MachineId: own.ID}))
+	require.NoError(t, err)
+}
+
+func TestService_MemberManagesOwnAuthKeys(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+
+	john := newTestPrincipal(t, repository, tailnet, "john@example.com", domain.UserRoleMember)
+	jane := newTestPrincipal(t, repository, tailnet, "jane@example.com", domain.UserRoleMember)
+
+	johnCtx := context.WithValue(context.Background(), principalKey, john)
+	janeCtx := context.WithValue(context.Background(), principalKey, jane)
+
+	_, err := s.CreateAuthKey(johnCtx, connect.NewRequest(&api.CreateAuthKeyRequest{TailnetId: tailnet.ID, Tags: []string{"tag:server"}}))
+	require.True(t, isPermissionDenied(err))
+
+	created, err := s.CreateAuthKey(johnCtx, connect.NewRequest(&api.CreateAuthKeyRequest{TailnetId: tailnet.ID, Expiry: durationpb.New(time.Hour)}))
+	require.NoError(t, err)
+
+	key, err := repository.GetAuthKey(context.Background(), created.Msg.AuthKey.Id)
+	require.NoError(t, err)
+	require.Equal(t, john.User.ID, key.UserID)
+
+	keys, err := s.ListAuthKeys(janeCtx, connect.NewRequest(&api.ListAuthKeysRequest{TailnetId: tailnet.ID}))
+	require.NoError(t, err)
+	require.Empty(t, keys.Msg.AuthKeys)
+
+	_, err = s.DeleteAuthKey(janeCtx, connect.NewRequest(&api.DeleteAuthKeyRequest{AuthKeyId: key.ID}))
+	require.True(t, isPermissionDenied(err))
+
+	_, err = s.DeleteAuthKey(johnCtx, connect.NewRequest(&api.DeleteAuthKeyRequest{AuthKeyId: key.ID}))
+	require.NoError(t, err)
+}
+
+func TestService_MemberManagesOwnApiKeys(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+
+	john := newTestPrincipal(t, repository, tailnet, "john@example.com", domain.UserRoleMember)
+	jane := newTestPrincipal(t, repository, tailnet, "jane@example.com", domain.UserRoleMember)
+
+	johnCtx := context.WithValue(context.Background(), principalKey, john)
+	janeCtx := context.WithValue(context.Background(), principalKey, jane)
+
+	created, err := s.CreateApiKey(johnCtx, connect.NewRequest(&api.CreateApiKeyRequest{Expiry: durationpb.New(time.Hour)}))
+	require.NoError(t, err)
+
+	loaded, err := repository.LoadApiKey(context.Background(), created.Msg.Value)
+	require.NoError(t, err)
+	require.Equal(t, john.User.ID, loaded.UserID)
+
+	keys, err := s.ListApiKeys(johnCtx, connect.NewRequest(&api.ListApiKeysRequest{}))
+	require.NoError(t, err)
+	require.Len(t, keys.Msg.ApiKeys, 1)
+
+	keys, err = s.ListApiKeys(janeCtx, connect.NewRequest(&api.ListApiKeysRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, keys.Msg.ApiKeys)
+
+	_, err = s.DeleteApiKey(janeCtx, connect.NewRequest(&api.DeleteApiKeyRequest{ApiKeyId: created.Msg.ApiKey.Id}))
+	require.True(t, isPermissionDenied(err))
+
+	_, err = s.DeleteApiKey(johnCtx, connect.NewRequest(&api.DeleteApiKeyRequest{ApiKeyId: created.Msg.ApiKey.Id}))
+	require.NoError(t, err)
+}
+
+func TestService_CreateApiKey_Expiry(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	john := newTestPrincipal(t, repository, tailnet, "john@example.com", domain.UserRoleMember)
+	johnCtx := context.WithValue(context.Background(), principalKey, john)
+
+	// without an expiry, the key expires after the configured default
+	created, err := s.CreateApiKey(johnCtx, connect.NewRequest(&api.CreateApiKeyRequest{}))
+	require.NoError(t, err)
+	require.NotNil(t, created.Msg.ApiKey.ExpiresAt)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), created.Msg.ApiKey.ExpiresAt.AsTime(), time.Minute)
+
+	loaded, err := repository.LoadApiKey(context.Background(), created.Msg.Value)
+	require.NoError(t, err)
+	require.NotNil(t, loaded.ExpiresAt)
+
+	_, err = s.CreateApiKey(johnCtx, connect.NewRequest(&api.CreateApiKeyRequest{Expiry: durationpb.New(91 * 24 * time.Hour)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = s.CreateApiKey(johnCtx, connect.NewRequest(&api.CreateApiKeyRequest{Expiry: durationpb.New(-time.Hour)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = s.CreateApiKey(johnCtx, connect.NewRequest(&api.CreateApiKeyRequest{Expiry: durationpb.New(90 * 24 * time.Hour)}))
+	require.NoError(t, err)
+}
@@ -9,28 +9,31 @@ import (
 	"github.com/jsiebens/ionscale/internal/auth"
 	"github.com/jsiebens/ionscale/internal/config"
 	"github.com/jsiebens/ionscale/internal/core"
+	"github.com/jsiebens/ionscale/internal/derp"
 	"github.com/jsiebens/ionscale/internal/dns"
 	"github.com/jsiebens/ionscale/internal/domain"
 	"github.com/jsiebens/ionscale/internal/version"
 	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
 )
 
-func NewService(config *config.Config, authProvider auth.Provider, dnsProvider dns.Provider, repository domain.Repository, sessionManager core.PollMapSessionManager) *Service {
+func NewService(config *config.Config, authProviders auth.Providers, dnsProvider dns.Provider, repository domain.Repository, sessionManager core.PollMapSessionManager, derpProber *derp.Prober) *Service {
 	return &Service{
 		config:         config,
-		authProvider:   authProvider,
+		authProviders:  authProviders,
 		dnsProvider:    dnsProvider,
 		repository:     repository,
 		sessionManager: sessionManager,
+		derpProber:     derpProber,
 	}
 }
 
 type Service struct {
 	config         *config.Config
-	authProvider   auth.Provider
+	authProviders  auth.Providers
 	dnsProvider    dns.Provider
 	repository     domain.Repository
 	sessionManager core.PollMapSessionManager
+	derpProber     *derp.Prober
 }
 
 func (s *Service) GetVersion(_ context.Context, _ *connect.Request[api.GetVersionRequest]) (*connect.Response[api.GetVersionResponse], error) {
@@ -48,5 +51,19 @@ func validateIamPolicy(p *domain.IAMPolicy) error {
 			mErr = multierror.Append(mErr, err)
 		}
 	}
+	for user, role := range p.Roles {
+		if !role.IsValid() {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid role '%s' for user '%s'", role, user))
+		}
+	}
 	return mErr.ErrorOrNil()
 }
+
+func (s *Service) validateAuthProviders(names []string) error {
+	for _, n := range names {
+		if s.authProviders.Get(n) == nil {
+			return fmt.Errorf("unknown auth provider '%s'", n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func machineShareToApi(share *domain.MachineShare) *api.MachineShare {
+	return &api.MachineShare{
+		Id: share.ID,
+		Machine: &api.Ref{
+			Id:   share.Machine.ID,
+			Name: share.Machine.CompleteName(),
+		},
+		SourceTailnet: &api.Ref{
+			Id:   share.SourceTailnet.ID,
+			Name: share.SourceTailnet.Name,
+		},
+		Tailnet: &api.Ref{
+			Id:   share.Tailnet.ID,
+			Name: share.Tailnet.Name,
+		},
+		Accepted:  share.Accepted,
+		CreatedAt: timestamppb.New(share.CreatedAt),
+	}
+}
+
+func (s *Service) ShareMachine(ctx context.Context, req *connect.Request[api.ShareMachineRequest]) (*connect.Response[api.ShareMachineResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	m, err := s.repository.GetMachine(ctx, req.Msg.MachineId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if m == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("machine not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(m.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnetByName(ctx, req.Msg.Tailnet)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	if tailnet.ID == m.TailnetID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a machine can not be shared with its own tailnet"))
+	}
+
+	shares, err := s.repository.ListMachineSharesByTailnet(ctx, tailnet.ID)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	for _, share := range shares {
+		if share.MachineID == m.ID && share.TailnetID == tailnet.ID {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("machine is already shared with tailnet %s", tailnet.Name))
+		}
+	}
+
+	share := &domain.MachineShare{
+		ID:              util.NextID(),
+		CreatedAt:       time.Now().UTC(),
+		MachineID:       m.ID,
+		Machine:         *m,
+		SourceTailnetID: m.TailnetID,
+		SourceTailnet:   m.Tailnet,
+		TailnetID:       tailnet.ID,
+		Tailnet:         *tailnet,
+	}
+
+	if err := s.repository.SaveMachineShare(ctx, share); err != nil {
+		return nil, logError(err)
+	}
+
+	return connect.NewResponse(&api.ShareMachineResponse{Share: machineShareToApi(share)}), nil
+}
+
+func (s *Service) ListMachineShares(ctx context.Context, req *connect.Request[api.ListMachineSharesRequest]) (*connect.Response[api.ListMachineSharesResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	shares, err := s.repository.ListMachineSharesByTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	var result []*api.MachineShare
+	for _, share := range shares {
+		result = append(result, machineShareToApi(&share))
+	}
+
+	return connect.NewResponse(&api.ListMachineSharesResponse{Shares: result}), nil
+}
+
+func (s *Service) AcceptMachineShare(ctx context.Context, req *connect.Request[api.AcceptMachineShareRequest]) (*connect.Response[api.AcceptMachineShareResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	share, err := s.repository.GetMachineShare(ctx, req.Msg.ShareId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if share == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("share not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(share.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if share.Accepted {
+		return connect.NewResponse(&api.AcceptMachineShareResponse{}), nil
+	}
+
+	share.Accepted = true
+
+	if err := s.repository.SaveMachineShare(ctx, share); err != nil {
+		return nil, logError(err)
+	}
+
+	s.sessionManager.NotifyAll(share.SourceTailnetID)
+	s.sessionManager.NotifyAll(share.TailnetID)
+
+	return connect.NewResponse(&api.AcceptMachineShareResponse{}), nil
+}
+
+// RevokeMachineShare removes a share, it can be revoked by both the sharing and the receiving tailnet
+func (s *Service) RevokeMachineShare(ctx context.Context, req *connect.Request[api.RevokeMachineShareRequest]) (*connect.Response[api.RevokeMachineShareResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	share, err := s.repository.GetMachineShare(ctx, req.Msg.ShareId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if share == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("share not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(share.SourceTailnetID) && !principal.IsTailnetITAdmin(share.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if err := s.repository.DeleteMachineShare(ctx, share.ID); err != nil {
+		return nil, logError(err)
+	}
+
+	s.sessionManager.NotifyAll(share.SourceTailnetID)
+	s.sessionManager.NotifyAll(share.TailnetID)
+
+	return connect.NewResponse(&api.RevokeMachineShareResponse{}), nil
+}
@@ -23,6 +23,7 @@ func domainTailnetToApiTailnet(tailnet *domain.Tailnet) (*api.Tailnet, error) {
 		FileSharingEnabled:          tailnet.FileSharingEnabled,
 		SshEnabled:                  tailnet.SSHEnabled,
 		MachineAuthorizationEnabled: tailnet.MachineAuthorizationEnabled,
+		AuthProviders:               tailnet.AuthProviders,
 	}
 
 	return t, nil
@@ -68,6 +69,18 @@ func (s *Service) CreateTailnet(ctx context.Context, req *connect.Request[api.Cr
 		req.Msg.DnsConfig = defaults.DefaultDNSConfig()
 	}
 
+	if err := validateDNSRecords(req.Msg.DnsConfig.ExtraRecords); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid dns config: %w", err))
+	}
+
+	if err := s.validateDNSDomain(ctx, 0, req.Msg.Name, req.Msg.DnsConfig); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateAuthProviders(req.Msg.AuthProviders); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	tailnet := &domain.Tailnet{
 		ID:                          util.NextID(),
 		Name:                        req.Msg.Name,
@@ -78,6 +91,7 @@ func (s *Service) CreateTailnet(ctx context.Context, req *connect.Request[api.Cr
 		FileSharingEnabled:          req.Msg.FileSharingEnabled,
 		SSHEnabled:                  req.Msg.SshEnabled,
 		MachineAuthorizationEnabled: req.Msg.MachineAuthorizationEnabled,
+		AuthProviders:               domain.SanitizeAuthProviders(req.Msg.AuthProviders),
 	}
 
 	if err := s.repository.SaveTailnet(ctx, tailnet); err != nil {
@@ -129,13 +143,24 @@ func (s *Service) UpdateTailnet(ctx context.Context, req *connect.Request[api.Up
 	}
 
 	if req.Msg.DnsConfig != nil {
+		if err := validateDNSRecords(req.Msg.DnsConfig.ExtraRecords); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid dns config: %w", err))
+		}
+		if err := s.validateDNSDomain(ctx, tailnet.ID, tailnet.Name, req.Msg.DnsConfig); err != nil {
+			return nil, err
+		}
 		tailnet.DNSConfig = apiDNSConfigToDomainDNSConfig(req.Msg.DnsConfig)
 	}
 
+	if err := s.validateAuthProviders(req.Msg.AuthProviders); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	tailnet.ServiceCollectionEnabled = req.Msg.ServiceCollectionEnabled
 	tailnet.FileSharingEnabled = req.Msg.FileSharingEnabled
 	tailnet.SSHEnabled = req.Msg.SshEnabled
 	tailnet.MachineAuthorizationEnabled = req.Msg.MachineAuthorizationEnabled
+	tailnet.AuthProviders = domain.SanitizeAuthProviders(req.Msg.AuthProviders)
 
 	if err := s.repository.SaveTailnet(ctx, tailnet); err != nil {
 		return nil, logError(err)
@@ -155,7 +180,7 @@ func (s *Service) UpdateTailnet(ctx context.Context, req *connect.Request[api.Up
 
 func (s *Service) GetTailnet(ctx context.Context, req *connect.Request[api.GetTailnetRequest]) (*connect.Response[api.GetTailnetResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.Id) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.Id) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -232,6 +257,26 @@ func (s *Service) DeleteTailnet(ctx context.Context, req *connect.Request[api.De
 			return err
 		}
 
+		if err := tx.DeleteGroupsByTailnet(ctx, req.Msg.TailnetId); err != nil {
+			return err
+		}
+
+		if err := tx.DeleteSCIMTokensByTailnet(ctx, req.Msg.TailnetId); err != nil {
+			return err
+		}
+
+		if err := tx.DeleteMachineSharesByTailnet(ctx, req.Msg.TailnetId); err != nil {
+			return err
+		}
+
+		if err := tx.DeleteTemporaryGrantsByTailnet(ctx, req.Msg.TailnetId); err != nil {
+			return err
+		}
+
+		if err := tx.DeleteInvitesByTailnet(ctx, req.Msg.TailnetId); err != nil {
+			return err
+		}
+
 		if err := tx.DeleteUsersByTailnet(ctx, req.Msg.TailnetId); err != nil {
 			return err
 		}
@@ -254,7 +299,7 @@ func (s *Service) DeleteTailnet(ctx context.Context, req *connect.Request[api.De
 
 func (s *Service) SetDERPMap(ctx context.Context, req *connect.Request[api.SetDERPMapRequest]) (*connect.Response[api.SetDERPMapResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -292,7 +337,7 @@ func (s *Service) SetDERPMap(ctx context.Context, req *connect.Request[api.SetDE
 
 func (s *Service) ResetDERPMap(ctx context.Context, req *connect.Request[api.ResetDERPMapRequest]) (*connect.Response[api.ResetDERPMapResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -317,7 +362,7 @@ func (s *Service) ResetDERPMap(ctx context.Context, req *connect.Request[api.Res
 
 func (s *Service) GetDERPMap(ctx context.Context, req *connect.Request[api.GetDERPMapRequest]) (*connect.Response[api.GetDERPMapResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -344,7 +389,7 @@ func (s *Service) GetDERPMap(ctx context.Context, req *connect.Request[api.GetDE
 
 func (s *Service) EnableFileSharing(ctx context.Context, req *connect.Request[api.EnableFileSharingRequest]) (*connect.Response[api.EnableFileSharingResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -370,7 +415,7 @@ func (s *Service) EnableFileSharing(ctx context.Context, req *connect.Request[ap
 
 func (s *Service) DisableFileSharing(ctx context.Context, req *connect.Request[api.DisableFileSharingRequest]) (*connect.Response[api.DisableFileSharingResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -396,7 +441,7 @@ func (s *Service) DisableFileSharing(ctx context.Context, req *connect.Request[a
 
 func (s *Service) EnableServiceCollection(ctx context.Context, req *connect.Request[api.EnableServiceCollectionRequest]) (*connect.Response[api.EnableServiceCollectionResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -422,7 +467,7 @@ func (s *Service) EnableServiceCollection(ctx context.Context, req *connect.Requ
 
 func (s *Service) DisableServiceCollection(ctx context.Context, req *connect.Request[api.DisableServiceCollectionRequest]) (*connect.Response[api.DisableServiceCollectionResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -448,7 +493,7 @@ func (s *Service) DisableServiceCollection(ctx context.Context, req *connect.Req
 
 func (s *Service) EnableSSH(ctx context.Context, req *connect.Request[api.EnableSSHRequest]) (*connect.Response[api.EnableSSHResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -474,7 +519,7 @@ func (s *Service) EnableSSH(ctx context.Context, req *connect.Request[api.Enable
 
 func (s *Service) DisableSSH(ctx context.Context, req *connect.Request[api.DisableSSHRequest]) (*connect.Response[api.DisableSSHResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -500,7 +545,7 @@ func (s *Service) DisableSSH(ctx context.Context, req *connect.Request[api.Disab
 
 func (s *Service) EnableMachineAuthorization(ctx context.Context, req *connect.Request[api.EnableMachineAuthorizationRequest]) (*connect.Response[api.EnableMachineAuthorizationResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -524,7 +569,7 @@ func (s *Service) EnableMachineAuthorization(ctx context.Context, req *connect.R
 
 func (s *Service) DisableMachineAuthorization(ctx context.Context, req *connect.Request[api.DisableMachineAuthorizationRequest]) (*connect.Response[api.DisableMachineAuthorizationResponse], error) {
 	principal := CurrentPrincipal(ctx)
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(req.Msg.TailnetId) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetITAdmin(req.Msg.TailnetId) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
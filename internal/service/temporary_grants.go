@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/internal/util"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func temporaryGrantToApi(g *domain.TemporaryGrant) *api.TemporaryGrant {
+	toTimestamp := func(t *time.Time) *timestamppb.Timestamp {
+		if t == nil {
+			return nil
+		}
+		return timestamppb.New(*t)
+	}
+
+	return &api.TemporaryGrant{
+		Id:          g.ID,
+		Src:         g.Source,
+		Dst:         g.Destination,
+		Ports:       g.Ports,
+		Duration:    durationpb.New(g.Duration),
+		Reason:      g.Reason,
+		State:       string(g.State),
+		RequestedBy: g.RequestedBy,
+		RequestedAt: timestamppb.New(g.RequestedAt),
+		ApprovedBy:  g.ApprovedBy,
+		ApprovedAt:  toTimestamp(g.ApprovedAt),
+		RevokedBy:   g.RevokedBy,
+		RevokedAt:   toTimestamp(g.RevokedAt),
+		ExpiresAt:   toTimestamp(g.ExpiresAt),
+	}
+}
+
+func principalName(p domain.Principal) string {
+	if p.User == nil {
+		return "system admin"
+	}
+	return p.User.Name
+}
+
+func principalUserID(p domain.Principal) uint64 {
+	if p.User == nil {
+		return 0
+	}
+	return p.User.ID
+}
+
+func auditTemporaryGrant(msg string, g *domain.TemporaryGrant, p domain.Principal) {
+	zap.L().Info(msg,
+		zap.Uint64("tailnet", g.TailnetID),
+		zap.Uint64("grant", g.ID),
+		zap.String("src", g.Source),
+		zap.String("dst", g.Destination),
+		zap.String("ports", g.Ports),
+		zap.Duration("duration", g.Duration),
+		zap.String("reason", g.Reason),
+		zap.String("principal", principalName(p)),
+	)
+}
+
+func (s *Service) RequestTemporaryGrant(ctx context.Context, req *connect.Request[api.RequestTemporaryGrantRequest]) (*connect.Response[api.RequestTemporaryGrantResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetMember(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	// members can only request access for themselves
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(req.Msg.TailnetId) && req.Msg.Src != principal.User.Name {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	tailnet, err := s.repository.GetTailnet(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if tailnet == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
+	}
+
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a reason is required"))
+	}
+
+	ports := req.Msg.Ports
+	if ports == "" {
+		ports = "*"
+	}
+
+	grant := &domain.TemporaryGrant{
+		ID:            util.NextID(),
+		TailnetID:     tailnet.ID,
+		Source:        req.Msg.Src,
+		Destination:   req.Msg.Dst,
+		Ports:         ports,
+		Duration:      req.Msg.Duration.AsDuration(),
+		Reason:        req.Msg.Reason,
+		State:         domain.TemporaryGrantPending,
+		RequestedBy:   principalName(principal),
+		RequestedByID: principalUserID(principal),
+		RequestedAt:   time.Now().UTC(),
+	}
+
+	policy, err := s.repository.GetACLPolicy(ctx, tailnet)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if err := grant.Validate(policy); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.repository.SaveTemporaryGrant(ctx, grant); err != nil {
+		return nil, logError(err)
+	}
+
+	auditTemporaryGrant("temporary grant requested", grant, principal)
+
+	return connect.NewResponse(&api.RequestTemporaryGrantResponse{Grant: temporaryGrantToApi(grant)}), nil
+}
+
+func (s *Service) ApproveTemporaryGrant(ctx context.Context, req *connect.Request[api.ApproveTemporaryGrantRequest]) (*connect.Response[api.ApproveTemporaryGrantResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	grant, err := s.repository.GetTemporaryGrant(ctx, req.Msg.GrantId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if grant == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("grant not found"))
+	}
+
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(grant.TailnetID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if grant.RequestedByID != 0 && grant.RequestedByID == principalUserID(principal) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("a grant can not be approved by its requester"))
+	}
+
+	if grant.State != domain.TemporaryGrantPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("grant is %s", grant.State))
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(grant.Duration)
+
+	grant.State = domain.TemporaryGrantApproved
+	grant.ApprovedBy = principalName(principal)
+	grant.ApprovedAt = &now
+	grant.ExpiresAt = &expiresAt
+
+	if err := s.repository.SaveTemporaryGrant(ctx, grant); err != nil {
+		return nil, logError(err)
+	}
+
+	auditTemporaryGrant("temporary grant approved", grant, principal)
+
+	s.sessionManager.NotifyAll(grant.TailnetID)
+
+	return connect.NewResponse(&api.ApproveTemporaryGrantResponse{Grant: temporaryGrantToApi(grant)}), nil
+}
+
+// RevokeTemporaryGrant ends a grant before it expires, or withdraws a pending request. Besides admins, the requester can revoke a grant as well.
+func (s *Service) RevokeTemporaryGrant(ctx context.Context, req *connect.Request[api.RevokeTemporaryGrantRequest]) (*connect.Response[api.RevokeTemporaryGrantResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	grant, err := s.repository.GetTemporaryGrant(ctx, req.Msg.GrantId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	if grant == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("grant not found"))
+	}
+
+	isRequester := grant.RequestedByID != 0 && grant.RequestedByID == principalUserID(principal)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetNetworkAdmin(grant.TailnetID) && !isRequester {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if grant.State != domain.TemporaryGrantPending && grant.State != domain.TemporaryGrantApproved {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("grant is %s", grant.State))
+	}
+
+	wasApproved := grant.State == domain.TemporaryGrantApproved
+	now := time.Now().UTC()
+
+	grant.State = domain.TemporaryGrantRevoked
+	grant.RevokedBy = principalName(principal)
+	grant.RevokedAt = &now
+
+	if err := s.repository.SaveTemporaryGrant(ctx, grant); err != nil {
+		return nil, logError(err)
+	}
+
+	auditTemporaryGrant("temporary grant revoked", grant, principal)
+
+	if wasApproved {
+		s.sessionManager.NotifyAll(grant.TailnetID)
+	}
+
+	return connect.NewResponse(&api.RevokeTemporaryGrantResponse{Grant: temporaryGrantToApi(grant)}), nil
+}
+
+func (s *Service) ListTemporaryGrants(ctx context.Context, req *connect.Request[api.ListTemporaryGrantsRequest]) (*connect.Response[api.ListTemporaryGrantsResponse], error) {
+	principal := CurrentPrincipal(ctx)
+	if !principal.IsSystemAdmin() && !principal.IsTailnetMember(req.Msg.TailnetId) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	grants, err := s.repository.ListTemporaryGrants(ctx, req.Msg.TailnetId)
+	if err != nil {
+		return nil, logError(err)
+	}
+
+	isAdmin := principal.IsSystemAdmin() || principal.IsTailnetAuditor(req.Msg.TailnetId)
+
+	var result []*api.TemporaryGrant
+	for _, g := range grants {
+		// members only see their own requests
+		if isAdmin || g.RequestedByID == principalUserID(principal) {
+			result = append(result, temporaryGrantToApi(&g))
+		}
+	}
+
+	return connect.NewResponse(&api.ListTemporaryGrantsResponse{Grants: result}), nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jsiebens/ionscale/internal/domain"
+	"github.com/jsiebens/ionscale/pkg/client/ionscale"
+	api "github.com/jsiebens/ionscale/pkg/gen/ionscale/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestService_TemporaryGrants(t *testing.T) {
+	s, repository := newTestService(t)
+
+	tailnet := newTestTailnet(t, repository, "tailnet")
+	tailnet.ACLPolicy = domain.NewHuJSON(&domain.ACLPolicy{ACLPolicy: ionscale.ACLPolicy{
+		TagOwners: map[string][]string{"tag:prod": {}},
+	}})
+	require.NoError(t, repository.SaveTailnet(context.Background(), tailnet))
+
+	john := newTestPrincipal(t, repository, tailnet, "john@example.com", domain.UserRoleMember)
+	alice := newTestPrincipal(t, repository, tailnet, "alice@example.com", domain.UserRoleNetworkAdmin)
+	bob := newTestPrincipal(t, repository, tailnet, "bob@example.com", domain.UserRoleNetworkAdmin)
+
+	johnCtx := context.WithValue(context.Background(), principalKey, john)
+	aliceCtx := context.WithValue(context.Background(), principalKey, alice)
+	bobCtx := context.WithValue(context.Background(), principalKey, bob)
+
+	request := func(ctx context.Context, src, dst string) (*api.TemporaryGrant, error) {
+		resp, err := s.RequestTemporaryGrant(ctx, connect.NewRequest(&api.RequestTemporaryGrantRequest{
+			TailnetId: tailnet.ID,
+			Src:       src,
+			Dst:       dst,
+			Ports:     "22",
+			Duration:  durationpb.New(time.Hour),
+			Reason:    "incident",
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Grant, nil
+	}
+
+	t.Run("members only request grants for themselves", func(t *testing.T) {
+		_, err := request(johnCtx, "jane@example.com", "tag:prod")
+		require.True(t, isPermissionDenied(err))
+
+		_, err = request(johnCtx, "group:sre", "tag:prod")
+		require.True(t, isPermissionDenied(err))
+
+		grant, err := request(johnCtx, "john@example.com", "tag:prod")
+		require.NoError(t, err)
+		require.Equal(t, string(domain.TemporaryGrantPending), grant.State)
+		require.Equal(t, "john@example.com", grant.RequestedBy)
+
+		// network admins request grants for others
+		_, err = request(aliceCtx, "group:sre", "tag:prod")
+		require.NoError(t, err)
+	})
+
+	t.Run("destination must be known in the policy", func(t *testing.T) {
+		_, err := request(johnCtx, "john@example.com", "tag:unknown")
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = request(johnCtx, "john@example.com", "unknown-host")
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("requester can't approve the own grant", func(t *testing.T) {
+		grant, err := request(aliceCtx, "alice@example.com", "tag:prod")
+		require.NoError(t, err)
+
+		_, err = s.ApproveTemporaryGrant(aliceCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.True(t, isPermissionDenied(err))
+
+		_, err = s.ApproveTemporaryGrant(johnCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.True(t, isPermissionDenied(err))
+
+		resp, err := s.ApproveTemporaryGrant(bobCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.NoError(t, err)
+		require.Equal(t, string(domain.TemporaryGrantApproved), resp.Msg.Grant.State)
+		require.Equal(t, "bob@example.com", resp.Msg.Grant.ApprovedBy)
+		require.NotNil(t, resp.Msg.Grant.ExpiresAt)
+	})
+
+	t.Run("state transitions", func(t *testing.T) {
+		grant, err := request(johnCtx, "john@example.com", "tag:prod")
+		require.NoError(t, err)
+
+		_, err = s.ApproveTemporaryGrant(bobCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.NoError(t, err)
+
+		active, err := repository.ListActiveTemporaryGrants(context.Background(), tailnet.ID)
+		require.NoError(t, err)
+		require.Contains(t, grantIDs(active), grant.Id)
+
+		// approved grants can't be approved again
+		_, err = s.ApproveTemporaryGrant(aliceCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		// the requester revokes the grant
+		resp, err := s.RevokeTemporaryGrant(johnCtx, connect.NewRequest(&api.RevokeTemporaryGrantRequest{GrantId: grant.Id}))
+		require.NoError(t, err)
+		require.Equal(t, string(domain.TemporaryGrantRevoked), resp.Msg.Grant.State)
+		require.Equal(t, "john@example.com", resp.Msg.Grant.RevokedBy)
+
+		active, err = repository.ListActiveTemporaryGrants(context.Background(), tailnet.ID)
+		require.NoError(t, err)
+		require.NotContains(t, grantIDs(active), grant.Id)
+
+		// revoked grants can't be approved or revoked again
+		_, err = s.ApproveTemporaryGrant(bobCtx, connect.NewRequest(&api.ApproveTemporaryGrantRequest{GrantId: grant.Id}))
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		_, err = s.RevokeTemporaryGrant(bobCtx, connect.NewRequest(&api.RevokeTemporaryGrantRequest{GrantId: grant.Id}))
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		// members can't revoke grants of others, the requester can withdraw a pending request
+		other, err := request(aliceCtx, "group:sre", "tag:prod")
+		require.NoError(t, err)
+
+		_, err = s.RevokeTemporaryGrant(johnCtx, connect.NewRequest(&api.RevokeTemporaryGrantRequest{GrantId: other.Id}))
+		require.True(t, isPermissionDenied(err))
+
+		pending, err := request(johnCtx, "john@example.com", "tag:prod")
+		require.NoError(t, err)
+
+		resp, err = s.RevokeTemporaryGrant(johnCtx, connect.NewRequest(&api.RevokeTemporaryGrantRequest{GrantId: pending.Id}))
+		require.NoError(t, err)
+		require.Equal(t, string(domain.TemporaryGrantRevoked), resp.Msg.Grant.State)
+	})
+
+	t.Run("members only list their own grants", func(t *testing.T) {
+		resp, err := s.ListTemporaryGrants(johnCtx, connect.NewRequest(&api.ListTemporaryGrantsRequest{TailnetId: tailnet.ID}))
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Msg.Grants)
+		for _, g := range resp.Msg.Grants {
+			require.Equal(t, "john@example.com", g.RequestedBy)
+		}
+	})
+}
+
+func grantIDs(grants []domain.TemporaryGrant) []uint64 {
+	var ids []uint64
+	for _, g := range grants {
+		ids = append(ids, g.ID)
+	}
+	return ids
+}
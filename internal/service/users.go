@@ -20,7 +20,7 @@ func (s *Service) ListUsers(ctx context.Context, req *connect.Request[api.ListUs
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tailnet not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(tailnet.ID) {
+	if !principal.IsSystemAdmin() && !principal.IsTailnetAuditor(tailnet.ID) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -32,9 +32,10 @@ func (s *Service) ListUsers(ctx context.Context, req *connect.Request[api.ListUs
 	resp := &api.ListUsersResponse{}
 	for _, u := range users {
 		resp.Users = append(resp.Users, &api.User{
-			Id:   u.ID,
-			Name: u.Name,
-			Role: string(tailnet.IAMPolicy.Get().GetRole(u)),
+			Id:        u.ID,
+			Name:      u.Name,
+			Role:      string(tailnet.IAMPolicy.Get().GetRole(u)),
+			Suspended: u.Suspended,
 		})
 	}
 
@@ -57,7 +58,7 @@ func (s *Service) DeleteUser(ctx context.Context, req *connect.Request[api.Delet
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user not found"))
 	}
 
-	if !principal.IsSystemAdmin() && !principal.IsTailnetAdmin(user.TailnetID) {
+	if !canManageUser(principal, user) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	}
 
@@ -93,3 +94,70 @@ func (s *Service) DeleteUser(ctx context.Context, req *connect.Request[api.Delet
 
 	return connect.NewResponse(&api.DeleteUserResponse{}), nil
 }
+
+// SuspendUser blocks a user from logging in and using auth keys, and isolates its machines from all peers.
+// Unlike DeleteUser, the user and its machines are kept, so the user can be reactivated later on.
+func (s *Service) SuspendUser(ctx context.Context, req *connect.Request[api.SuspendUserRequest]) (*connect.Response[api.SuspendUserResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	if !principal.IsSystemAdmin() && principal.UserMatches(req.Msg.UserId) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to suspend yourself"))
+	}
+
+	if err := s.setUserSuspended(ctx, principal, req.Msg.UserId, true); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.SuspendUserResponse{}), nil
+}
+
+func (s *Service) ReactivateUser(ctx context.Context, req *connect.Request[api.ReactivateUserRequest]) (*connect.Response[api.ReactivateUserResponse], error) {
+	principal := CurrentPrincipal(ctx)
+
+	if err := s.setUserSuspended(ctx, principal, req.Msg.UserId, false); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.ReactivateUserResponse{}), nil
+}
+
+func (s *Service) setUserSuspended(ctx context.Context, principal domain.Principal, userID uint64, suspended bool) error {
+	user, err := s.repository.GetUser(ctx, userID)
+	if err != nil {
+		return logError(err)
+	}
+
+	if user == nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("user not found"))
+	}
+
+	if !canManageUser(principal, user) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	if user.UserType == domain.UserTypeService {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to suspend service account"))
+	}
+
+	if user.Suspended == suspended {
+		return nil
+	}
+
+	user.Suspended = suspended
+	if err := s.repository.SaveUser(ctx, user); err != nil {
+		return logError(err)
+	}
+
+	s.sessionManager.NotifyAll(user.TailnetID)
+
+	return nil
+}
+
+// canManageUser returns whether the principal may delete, suspend or reactivate the user,
+// IT admins manage the users of their tailnet, except for the admins.
+func canManageUser(principal domain.Principal, user *domain.User) bool {
+	if principal.IsSystemAdmin() || principal.IsTailnetAdmin(user.TailnetID) {
+		return true
+	}
+	return principal.IsTailnetITAdmin(user.TailnetID) && user.Tailnet.IAMPolicy.Get().GetRole(*user) != domain.UserRoleAdmin
+}
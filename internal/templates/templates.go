@@ -12,6 +12,9 @@ type Renderer struct {
 
 func (t *Renderer) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
 	if x, ok := data.(templ.Component); ok {
+		if name == "console" {
+			return consoleLayout(x).Render(c.Request().Context(), w)
+		}
 		return layout(x).Render(c.Request().Context(), w)
 	}
 
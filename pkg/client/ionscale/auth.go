@@ -49,6 +49,11 @@ func LoadClientAuth(addr string, systemAdminKey string) (ClientAuth, error) {
 	return ds, nil
 }
 
+// ApiKeyAuth uses an api key, e.g. created by a tailnet member, to authenticate the calls
+func ApiKeyAuth(apiKey string) ClientAuth {
+	return defaultSession{TK: apiKey}
+}
+
 func StoreAuthToken(addr, token string, tailnetID uint64) error {
 	ring, err := openKeyring()
 	if err != nil {
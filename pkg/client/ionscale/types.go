@@ -2,6 +2,7 @@ package ionscale
 
 import (
 	"encoding/json"
+	"net/netip"
 	"tailscale.com/tailcfg"
 )
 
@@ -26,6 +27,7 @@ type ACLPolicy struct {
 	SSH           []ACLSSH            `json:"ssh,omitempty" hujson:"SSH,omitempty"`
 	NodeAttrs     []ACLNodeAttrGrant  `json:"nodeAttrs,omitempty" hujson:"NodeAttrs,omitempty"`
 	Grants        []ACLGrant          `json:"grants,omitempty" hujson:"Grants,omitempty"`
+	AppConnectors []ACLAppConnector   `json:"appConnectors,omitempty" hujson:"AppConnectors,omitempty"`
 }
 
 func (a ACLPolicy) Marshal() string {
@@ -56,8 +58,9 @@ type ACLSSH struct {
 }
 
 type ACLNodeAttrGrant struct {
-	Target []string `json:"target,omitempty" hujson:"Target,omitempty"`
-	Attr   []string `json:"attr,omitempty" hujson:"Attr,omitempty"`
+	Target []string           `json:"target,omitempty" hujson:"Target,omitempty"`
+	Attr   []string           `json:"attr,omitempty" hujson:"Attr,omitempty"`
+	App    tailcfg.NodeCapMap `json:"app,omitempty" hujson:"App,omitempty"`
 }
 
 type ACLGrant struct {
@@ -65,4 +68,12 @@ type ACLGrant struct {
 	Destination []string                 `json:"dst,omitempty" hujson:"Dst,omitempty"`
 	IP          []tailcfg.ProtoPortRange `json:"ip,omitempty" hujson:"Ip,omitempty"`
 	App         tailcfg.PeerCapMap       `json:"app,omitempty" hujson:"App,omitempty"`
+	Via         []string                 `json:"via,omitempty" hujson:"Via,omitempty"`
+}
+
+type ACLAppConnector struct {
+	Name       string         `json:"name,omitempty" hujson:"Name,omitempty"`
+	Connectors []string       `json:"connectors,omitempty" hujson:"Connectors,omitempty"`
+	Domains    []string       `json:"domains,omitempty" hujson:"Domains,omitempty"`
+	Routes     []netip.Prefix `json:"routes,omitempty" hujson:"Routes,omitempty"`
 }
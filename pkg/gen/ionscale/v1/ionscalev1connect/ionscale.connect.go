@@ -42,6 +42,9 @@ const (
 	// IonscaleServiceGetDefaultDERPMapProcedure is the fully-qualified name of the IonscaleService's
 	// GetDefaultDERPMap RPC.
 	IonscaleServiceGetDefaultDERPMapProcedure = "/ionscale.v1.IonscaleService/GetDefaultDERPMap"
+	// IonscaleServiceGetDERPHealthProcedure is the fully-qualified name of the IonscaleService's
+	// GetDERPHealth RPC.
+	IonscaleServiceGetDERPHealthProcedure = "/ionscale.v1.IonscaleService/GetDERPHealth"
 	// IonscaleServiceCreateTailnetProcedure is the fully-qualified name of the IonscaleService's
 	// CreateTailnet RPC.
 	IonscaleServiceCreateTailnetProcedure = "/ionscale.v1.IonscaleService/CreateTailnet"
@@ -90,12 +93,24 @@ const (
 	// IonscaleServiceDisableMachineAuthorizationProcedure is the fully-qualified name of the
 	// IonscaleService's DisableMachineAuthorization RPC.
 	IonscaleServiceDisableMachineAuthorizationProcedure = "/ionscale.v1.IonscaleService/DisableMachineAuthorization"
+	// IonscaleServiceEnableSCIMProcedure is the fully-qualified name of the IonscaleService's
+	// EnableSCIM RPC.
+	IonscaleServiceEnableSCIMProcedure = "/ionscale.v1.IonscaleService/EnableSCIM"
+	// IonscaleServiceDisableSCIMProcedure is the fully-qualified name of the IonscaleService's
+	// DisableSCIM RPC.
+	IonscaleServiceDisableSCIMProcedure = "/ionscale.v1.IonscaleService/DisableSCIM"
 	// IonscaleServiceGetDNSConfigProcedure is the fully-qualified name of the IonscaleService's
 	// GetDNSConfig RPC.
 	IonscaleServiceGetDNSConfigProcedure = "/ionscale.v1.IonscaleService/GetDNSConfig"
 	// IonscaleServiceSetDNSConfigProcedure is the fully-qualified name of the IonscaleService's
 	// SetDNSConfig RPC.
 	IonscaleServiceSetDNSConfigProcedure = "/ionscale.v1.IonscaleService/SetDNSConfig"
+	// IonscaleServiceGetAuthProvidersProcedure is the fully-qualified name of the IonscaleService's
+	// GetAuthProviders RPC.
+	IonscaleServiceGetAuthProvidersProcedure = "/ionscale.v1.IonscaleService/GetAuthProviders"
+	// IonscaleServiceSetAuthProvidersProcedure is the fully-qualified name of the IonscaleService's
+	// SetAuthProviders RPC.
+	IonscaleServiceSetAuthProvidersProcedure = "/ionscale.v1.IonscaleService/SetAuthProviders"
 	// IonscaleServiceGetIAMPolicyProcedure is the fully-qualified name of the IonscaleService's
 	// GetIAMPolicy RPC.
 	IonscaleServiceGetIAMPolicyProcedure = "/ionscale.v1.IonscaleService/GetIAMPolicy"
@@ -120,12 +135,36 @@ const (
 	// IonscaleServiceListAuthKeysProcedure is the fully-qualified name of the IonscaleService's
 	// ListAuthKeys RPC.
 	IonscaleServiceListAuthKeysProcedure = "/ionscale.v1.IonscaleService/ListAuthKeys"
+	// IonscaleServiceCreateApiKeyProcedure is the fully-qualified name of the IonscaleService's
+	// CreateApiKey RPC.
+	IonscaleServiceCreateApiKeyProcedure = "/ionscale.v1.IonscaleService/CreateApiKey"
+	// IonscaleServiceDeleteApiKeyProcedure is the fully-qualified name of the IonscaleService's
+	// DeleteApiKey RPC.
+	IonscaleServiceDeleteApiKeyProcedure = "/ionscale.v1.IonscaleService/DeleteApiKey"
+	// IonscaleServiceListApiKeysProcedure is the fully-qualified name of the IonscaleService's
+	// ListApiKeys RPC.
+	IonscaleServiceListApiKeysProcedure = "/ionscale.v1.IonscaleService/ListApiKeys"
 	// IonscaleServiceListUsersProcedure is the fully-qualified name of the IonscaleService's ListUsers
 	// RPC.
 	IonscaleServiceListUsersProcedure = "/ionscale.v1.IonscaleService/ListUsers"
 	// IonscaleServiceDeleteUserProcedure is the fully-qualified name of the IonscaleService's
 	// DeleteUser RPC.
 	IonscaleServiceDeleteUserProcedure = "/ionscale.v1.IonscaleService/DeleteUser"
+	// IonscaleServiceSuspendUserProcedure is the fully-qualified name of the IonscaleService's
+	// SuspendUser RPC.
+	IonscaleServiceSuspendUserProcedure = "/ionscale.v1.IonscaleService/SuspendUser"
+	// IonscaleServiceReactivateUserProcedure is the fully-qualified name of the IonscaleService's
+	// ReactivateUser RPC.
+	IonscaleServiceReactivateUserProcedure = "/ionscale.v1.IonscaleService/ReactivateUser"
+	// IonscaleServiceCreateInviteProcedure is the fully-qualified name of the IonscaleService's
+	// CreateInvite RPC.
+	IonscaleServiceCreateInviteProcedure = "/ionscale.v1.IonscaleService/CreateInvite"
+	// IonscaleServiceDeleteInviteProcedure is the fully-qualified name of the IonscaleService's
+	// DeleteInvite RPC.
+	IonscaleServiceDeleteInviteProcedure = "/ionscale.v1.IonscaleService/DeleteInvite"
+	// IonscaleServiceListInvitesProcedure is the fully-qualified name of the IonscaleService's
+	// ListInvites RPC.
+	IonscaleServiceListInvitesProcedure = "/ionscale.v1.IonscaleService/ListInvites"
 	// IonscaleServiceGetMachineProcedure is the fully-qualified name of the IonscaleService's
 	// GetMachine RPC.
 	IonscaleServiceGetMachineProcedure = "/ionscale.v1.IonscaleService/GetMachine"
@@ -138,6 +177,12 @@ const (
 	// IonscaleServiceAuthorizeMachineProcedure is the fully-qualified name of the IonscaleService's
 	// AuthorizeMachine RPC.
 	IonscaleServiceAuthorizeMachineProcedure = "/ionscale.v1.IonscaleService/AuthorizeMachine"
+	// IonscaleServiceQuarantineMachineProcedure is the fully-qualified name of the IonscaleService's
+	// QuarantineMachine RPC.
+	IonscaleServiceQuarantineMachineProcedure = "/ionscale.v1.IonscaleService/QuarantineMachine"
+	// IonscaleServiceUnquarantineMachineProcedure is the fully-qualified name of the IonscaleService's
+	// UnquarantineMachine RPC.
+	IonscaleServiceUnquarantineMachineProcedure = "/ionscale.v1.IonscaleService/UnquarantineMachine"
 	// IonscaleServiceExpireMachineProcedure is the fully-qualified name of the IonscaleService's
 	// ExpireMachine RPC.
 	IonscaleServiceExpireMachineProcedure = "/ionscale.v1.IonscaleService/ExpireMachine"
@@ -162,6 +207,30 @@ const (
 	// IonscaleServiceDisableExitNodeProcedure is the fully-qualified name of the IonscaleService's
 	// DisableExitNode RPC.
 	IonscaleServiceDisableExitNodeProcedure = "/ionscale.v1.IonscaleService/DisableExitNode"
+	// IonscaleServiceShareMachineProcedure is the fully-qualified name of the IonscaleService's
+	// ShareMachine RPC.
+	IonscaleServiceShareMachineProcedure = "/ionscale.v1.IonscaleService/ShareMachine"
+	// IonscaleServiceListMachineSharesProcedure is the fully-qualified name of the IonscaleService's
+	// ListMachineShares RPC.
+	IonscaleServiceListMachineSharesProcedure = "/ionscale.v1.IonscaleService/ListMachineShares"
+	// IonscaleServiceAcceptMachineShareProcedure is the fully-qualified name of the IonscaleService's
+	// AcceptMachineShare RPC.
+	IonscaleServiceAcceptMachineShareProcedure = "/ionscale.v1.IonscaleService/AcceptMachineShare"
+	// IonscaleServiceRevokeMachineShareProcedure is the fully-qualified name of the IonscaleService's
+	// RevokeMachineShare RPC.
+	IonscaleServiceRevokeMachineShareProcedure = "/ionscale.v1.IonscaleService/RevokeMachineShare"
+	// IonscaleServiceRequestTemporaryGrantProcedure is the fully-qualified name of the
+	// IonscaleService's RequestTemporaryGrant RPC.
+	IonscaleServiceRequestTemporaryGrantProcedure = "/ionscale.v1.IonscaleService/RequestTemporaryGrant"
+	// IonscaleServiceApproveTemporaryGrantProcedure is the fully-qualified name of the
+	// IonscaleService's ApproveTemporaryGrant RPC.
+	IonscaleServiceApproveTemporaryGrantProcedure = "/ionscale.v1.IonscaleService/ApproveTemporaryGrant"
+	// IonscaleServiceRevokeTemporaryGrantProcedure is the fully-qualified name of the IonscaleService's
+	// RevokeTemporaryGrant RPC.
+	IonscaleServiceRevokeTemporaryGrantProcedure = "/ionscale.v1.IonscaleService/RevokeTemporaryGrant"
+	// IonscaleServiceListTemporaryGrantsProcedure is the fully-qualified name of the IonscaleService's
+	// ListTemporaryGrants RPC.
+	IonscaleServiceListTemporaryGrantsProcedure = "/ionscale.v1.IonscaleService/ListTemporaryGrants"
 )
 
 // IonscaleServiceClient is a client for the ionscale.v1.IonscaleService service.
@@ -169,6 +238,7 @@ type IonscaleServiceClient interface {
 	GetVersion(context.Context, *connect_go.Request[v1.GetVersionRequest]) (*connect_go.Response[v1.GetVersionResponse], error)
 	Authenticate(context.Context, *connect_go.Request[v1.AuthenticateRequest]) (*connect_go.ServerStreamForClient[v1.AuthenticateResponse], error)
 	GetDefaultDERPMap(context.Context, *connect_go.Request[v1.GetDefaultDERPMapRequest]) (*connect_go.Response[v1.GetDefaultDERPMapResponse], error)
+	GetDERPHealth(context.Context, *connect_go.Request[v1.GetDERPHealthRequest]) (*connect_go.Response[v1.GetDERPHealthResponse], error)
 	CreateTailnet(context.Context, *connect_go.Request[v1.CreateTailnetRequest]) (*connect_go.Response[v1.CreateTailnetResponse], error)
 	UpdateTailnet(context.Context, *connect_go.Request[v1.UpdateTailnetRequest]) (*connect_go.Response[v1.UpdateTailnetResponse], error)
 	GetTailnet(context.Context, *connect_go.Request[v1.GetTailnetRequest]) (*connect_go.Response[v1.GetTailnetResponse], error)
@@ -185,8 +255,12 @@ type IonscaleServiceClient interface {
 	DisableSSH(context.Context, *connect_go.Request[v1.DisableSSHRequest]) (*connect_go.Response[v1.DisableSSHResponse], error)
 	EnableMachineAuthorization(context.Context, *connect_go.Request[v1.EnableMachineAuthorizationRequest]) (*connect_go.Response[v1.EnableMachineAuthorizationResponse], error)
 	DisableMachineAuthorization(context.Context, *connect_go.Request[v1.DisableMachineAuthorizationRequest]) (*connect_go.Response[v1.DisableMachineAuthorizationResponse], error)
+	EnableSCIM(context.Context, *connect_go.Request[v1.EnableSCIMRequest]) (*connect_go.Response[v1.EnableSCIMResponse], error)
+	DisableSCIM(context.Context, *connect_go.Request[v1.DisableSCIMRequest]) (*connect_go.Response[v1.DisableSCIMResponse], error)
 	GetDNSConfig(context.Context, *connect_go.Request[v1.GetDNSConfigRequest]) (*connect_go.Response[v1.GetDNSConfigResponse], error)
 	SetDNSConfig(context.Context, *connect_go.Request[v1.SetDNSConfigRequest]) (*connect_go.Response[v1.SetDNSConfigResponse], error)
+	GetAuthProviders(context.Context, *connect_go.Request[v1.GetAuthProvidersRequest]) (*connect_go.Response[v1.GetAuthProvidersResponse], error)
+	SetAuthProviders(context.Context, *connect_go.Request[v1.SetAuthProvidersRequest]) (*connect_go.Response[v1.SetAuthProvidersResponse], error)
 	GetIAMPolicy(context.Context, *connect_go.Request[v1.GetIAMPolicyRequest]) (*connect_go.Response[v1.GetIAMPolicyResponse], error)
 	SetIAMPolicy(context.Context, *connect_go.Request[v1.SetIAMPolicyRequest]) (*connect_go.Response[v1.SetIAMPolicyResponse], error)
 	GetACLPolicy(context.Context, *connect_go.Request[v1.GetACLPolicyRequest]) (*connect_go.Response[v1.GetACLPolicyResponse], error)
@@ -195,12 +269,22 @@ type IonscaleServiceClient interface {
 	CreateAuthKey(context.Context, *connect_go.Request[v1.CreateAuthKeyRequest]) (*connect_go.Response[v1.CreateAuthKeyResponse], error)
 	DeleteAuthKey(context.Context, *connect_go.Request[v1.DeleteAuthKeyRequest]) (*connect_go.Response[v1.DeleteAuthKeyResponse], error)
 	ListAuthKeys(context.Context, *connect_go.Request[v1.ListAuthKeysRequest]) (*connect_go.Response[v1.ListAuthKeysResponse], error)
+	CreateApiKey(context.Context, *connect_go.Request[v1.CreateApiKeyRequest]) (*connect_go.Response[v1.CreateApiKeyResponse], error)
+	DeleteApiKey(context.Context, *connect_go.Request[v1.DeleteApiKeyRequest]) (*connect_go.Response[v1.DeleteApiKeyResponse], error)
+	ListApiKeys(context.Context, *connect_go.Request[v1.ListApiKeysRequest]) (*connect_go.Response[v1.ListApiKeysResponse], error)
 	ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error)
 	DeleteUser(context.Context, *connect_go.Request[v1.DeleteUserRequest]) (*connect_go.Response[v1.DeleteUserResponse], error)
+	SuspendUser(context.Context, *connect_go.Request[v1.SuspendUserRequest]) (*connect_go.Response[v1.SuspendUserResponse], error)
+	ReactivateUser(context.Context, *connect_go.Request[v1.ReactivateUserRequest]) (*connect_go.Response[v1.ReactivateUserResponse], error)
+	CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error)
+	DeleteInvite(context.Context, *connect_go.Request[v1.DeleteInviteRequest]) (*connect_go.Response[v1.DeleteInviteResponse], error)
+	ListInvites(context.Context, *connect_go.Request[v1.ListInvitesRequest]) (*connect_go.Response[v1.ListInvitesResponse], error)
 	GetMachine(context.Context, *connect_go.Request[v1.GetMachineRequest]) (*connect_go.Response[v1.GetMachineResponse], error)
 	ListMachines(context.Context, *connect_go.Request[v1.ListMachinesRequest]) (*connect_go.Response[v1.ListMachinesResponse], error)
 	SetMachineName(context.Context, *connect_go.Request[v1.SetMachineNameRequest]) (*connect_go.Response[v1.SetMachineNameResponse], error)
 	AuthorizeMachine(context.Context, *connect_go.Request[v1.AuthorizeMachineRequest]) (*connect_go.Response[v1.AuthorizeMachineResponse], error)
+	QuarantineMachine(context.Context, *connect_go.Request[v1.QuarantineMachineRequest]) (*connect_go.Response[v1.QuarantineMachineResponse], error)
+	UnquarantineMachine(context.Context, *connect_go.Request[v1.UnquarantineMachineRequest]) (*connect_go.Response[v1.UnquarantineMachineResponse], error)
 	ExpireMachine(context.Context, *connect_go.Request[v1.ExpireMachineRequest]) (*connect_go.Response[v1.ExpireMachineResponse], error)
 	DeleteMachine(context.Context, *connect_go.Request[v1.DeleteMachineRequest]) (*connect_go.Response[v1.DeleteMachineResponse], error)
 	SetMachineKeyExpiry(context.Context, *connect_go.Request[v1.SetMachineKeyExpiryRequest]) (*connect_go.Response[v1.SetMachineKeyExpiryResponse], error)
@@ -209,6 +293,14 @@ type IonscaleServiceClient interface {
 	DisableMachineRoutes(context.Context, *connect_go.Request[v1.DisableMachineRoutesRequest]) (*connect_go.Response[v1.DisableMachineRoutesResponse], error)
 	EnableExitNode(context.Context, *connect_go.Request[v1.EnableExitNodeRequest]) (*connect_go.Response[v1.EnableExitNodeResponse], error)
 	DisableExitNode(context.Context, *connect_go.Request[v1.DisableExitNodeRequest]) (*connect_go.Response[v1.DisableExitNodeResponse], error)
+	ShareMachine(context.Context, *connect_go.Request[v1.ShareMachineRequest]) (*connect_go.Response[v1.ShareMachineResponse], error)
+	ListMachineShares(context.Context, *connect_go.Request[v1.ListMachineSharesRequest]) (*connect_go.Response[v1.ListMachineSharesResponse], error)
+	AcceptMachineShare(context.Context, *connect_go.Request[v1.AcceptMachineShareRequest]) (*connect_go.Response[v1.AcceptMachineShareResponse], error)
+	RevokeMachineShare(context.Context, *connect_go.Request[v1.RevokeMachineShareRequest]) (*connect_go.Response[v1.RevokeMachineShareResponse], error)
+	RequestTemporaryGrant(context.Context, *connect_go.Request[v1.RequestTemporaryGrantRequest]) (*connect_go.Response[v1.RequestTemporaryGrantResponse], error)
+	ApproveTemporaryGrant(context.Context, *connect_go.Request[v1.ApproveTemporaryGrantRequest]) (*connect_go.Response[v1.ApproveTemporaryGrantResponse], error)
+	RevokeTemporaryGrant(context.Context, *connect_go.Request[v1.RevokeTemporaryGrantRequest]) (*connect_go.Response[v1.RevokeTemporaryGrantResponse], error)
+	ListTemporaryGrants(context.Context, *connect_go.Request[v1.ListTemporaryGrantsRequest]) (*connect_go.Response[v1.ListTemporaryGrantsResponse], error)
 }
 
 // NewIonscaleServiceClient constructs a client for the ionscale.v1.IonscaleService service. By
@@ -236,6 +328,11 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceGetDefaultDERPMapProcedure,
 			opts...,
 		),
+		getDERPHealth: connect_go.NewClient[v1.GetDERPHealthRequest, v1.GetDERPHealthResponse](
+			httpClient,
+			baseURL+IonscaleServiceGetDERPHealthProcedure,
+			opts...,
+		),
 		createTailnet: connect_go.NewClient[v1.CreateTailnetRequest, v1.CreateTailnetResponse](
 			httpClient,
 			baseURL+IonscaleServiceCreateTailnetProcedure,
@@ -316,6 +413,16 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceDisableMachineAuthorizationProcedure,
 			opts...,
 		),
+		enableSCIM: connect_go.NewClient[v1.EnableSCIMRequest, v1.EnableSCIMResponse](
+			httpClient,
+			baseURL+IonscaleServiceEnableSCIMProcedure,
+			opts...,
+		),
+		disableSCIM: connect_go.NewClient[v1.DisableSCIMRequest, v1.DisableSCIMResponse](
+			httpClient,
+			baseURL+IonscaleServiceDisableSCIMProcedure,
+			opts...,
+		),
 		getDNSConfig: connect_go.NewClient[v1.GetDNSConfigRequest, v1.GetDNSConfigResponse](
 			httpClient,
 			baseURL+IonscaleServiceGetDNSConfigProcedure,
@@ -326,6 +433,16 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceSetDNSConfigProcedure,
 			opts...,
 		),
+		getAuthProviders: connect_go.NewClient[v1.GetAuthProvidersRequest, v1.GetAuthProvidersResponse](
+			httpClient,
+			baseURL+IonscaleServiceGetAuthProvidersProcedure,
+			opts...,
+		),
+		setAuthProviders: connect_go.NewClient[v1.SetAuthProvidersRequest, v1.SetAuthProvidersResponse](
+			httpClient,
+			baseURL+IonscaleServiceSetAuthProvidersProcedure,
+			opts...,
+		),
 		getIAMPolicy: connect_go.NewClient[v1.GetIAMPolicyRequest, v1.GetIAMPolicyResponse](
 			httpClient,
 			baseURL+IonscaleServiceGetIAMPolicyProcedure,
@@ -366,6 +483,21 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceListAuthKeysProcedure,
 			opts...,
 		),
+		createApiKey: connect_go.NewClient[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse](
+			httpClient,
+			baseURL+IonscaleServiceCreateApiKeyProcedure,
+			opts...,
+		),
+		deleteApiKey: connect_go.NewClient[v1.DeleteApiKeyRequest, v1.DeleteApiKeyResponse](
+			httpClient,
+			baseURL+IonscaleServiceDeleteApiKeyProcedure,
+			opts...,
+		),
+		listApiKeys: connect_go.NewClient[v1.ListApiKeysRequest, v1.ListApiKeysResponse](
+			httpClient,
+			baseURL+IonscaleServiceListApiKeysProcedure,
+			opts...,
+		),
 		listUsers: connect_go.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
 			httpClient,
 			baseURL+IonscaleServiceListUsersProcedure,
@@ -376,6 +508,31 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceDeleteUserProcedure,
 			opts...,
 		),
+		suspendUser: connect_go.NewClient[v1.SuspendUserRequest, v1.SuspendUserResponse](
+			httpClient,
+			baseURL+IonscaleServiceSuspendUserProcedure,
+			opts...,
+		),
+		reactivateUser: connect_go.NewClient[v1.ReactivateUserRequest, v1.ReactivateUserResponse](
+			httpClient,
+			baseURL+IonscaleServiceReactivateUserProcedure,
+			opts...,
+		),
+		createInvite: connect_go.NewClient[v1.CreateInviteRequest, v1.CreateInviteResponse](
+			httpClient,
+			baseURL+IonscaleServiceCreateInviteProcedure,
+			opts...,
+		),
+		deleteInvite: connect_go.NewClient[v1.DeleteInviteRequest, v1.DeleteInviteResponse](
+			httpClient,
+			baseURL+IonscaleServiceDeleteInviteProcedure,
+			opts...,
+		),
+		listInvites: connect_go.NewClient[v1.ListInvitesRequest, v1.ListInvitesResponse](
+			httpClient,
+			baseURL+IonscaleServiceListInvitesProcedure,
+			opts...,
+		),
 		getMachine: connect_go.NewClient[v1.GetMachineRequest, v1.GetMachineResponse](
 			httpClient,
 			baseURL+IonscaleServiceGetMachineProcedure,
@@ -396,6 +553,16 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceAuthorizeMachineProcedure,
 			opts...,
 		),
+		quarantineMachine: connect_go.NewClient[v1.QuarantineMachineRequest, v1.QuarantineMachineResponse](
+			httpClient,
+			baseURL+IonscaleServiceQuarantineMachineProcedure,
+			opts...,
+		),
+		unquarantineMachine: connect_go.NewClient[v1.UnquarantineMachineRequest, v1.UnquarantineMachineResponse](
+			httpClient,
+			baseURL+IonscaleServiceUnquarantineMachineProcedure,
+			opts...,
+		),
 		expireMachine: connect_go.NewClient[v1.ExpireMachineRequest, v1.ExpireMachineResponse](
 			httpClient,
 			baseURL+IonscaleServiceExpireMachineProcedure,
@@ -436,6 +603,46 @@ func NewIonscaleServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+IonscaleServiceDisableExitNodeProcedure,
 			opts...,
 		),
+		shareMachine: connect_go.NewClient[v1.ShareMachineRequest, v1.ShareMachineResponse](
+			httpClient,
+			baseURL+IonscaleServiceShareMachineProcedure,
+			opts...,
+		),
+		listMachineShares: connect_go.NewClient[v1.ListMachineSharesRequest, v1.ListMachineSharesResponse](
+			httpClient,
+			baseURL+IonscaleServiceListMachineSharesProcedure,
+			opts...,
+		),
+		acceptMachineShare: connect_go.NewClient[v1.AcceptMachineShareRequest, v1.AcceptMachineShareResponse](
+			httpClient,
+			baseURL+IonscaleServiceAcceptMachineShareProcedure,
+			opts...,
+		),
+		revokeMachineShare: connect_go.NewClient[v1.RevokeMachineShareRequest, v1.RevokeMachineShareResponse](
+			httpClient,
+			baseURL+IonscaleServiceRevokeMachineShareProcedure,
+			opts...,
+		),
+		requestTemporaryGrant: connect_go.NewClient[v1.RequestTemporaryGrantRequest, v1.RequestTemporaryGrantResponse](
+			httpClient,
+			baseURL+IonscaleServiceRequestTemporaryGrantProcedure,
+			opts...,
+		),
+		approveTemporaryGrant: connect_go.NewClient[v1.ApproveTemporaryGrantRequest, v1.ApproveTemporaryGrantResponse](
+			httpClient,
+			baseURL+IonscaleServiceApproveTemporaryGrantProcedure,
+			opts...,
+		),
+		revokeTemporaryGrant: connect_go.NewClient[v1.RevokeTemporaryGrantRequest, v1.RevokeTemporaryGrantResponse](
+			httpClient,
+			baseURL+IonscaleServiceRevokeTemporaryGrantProcedure,
+			opts...,
+		),
+		listTemporaryGrants: connect_go.NewClient[v1.ListTemporaryGrantsRequest, v1.ListTemporaryGrantsResponse](
+			httpClient,
+			baseURL+IonscaleServiceListTemporaryGrantsProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -444,6 +651,7 @@ type ionscaleServiceClient struct {
 	getVersion                  *connect_go.Client[v1.GetVersionRequest, v1.GetVersionResponse]
 	authenticate                *connect_go.Client[v1.AuthenticateRequest, v1.AuthenticateResponse]
 	getDefaultDERPMap           *connect_go.Client[v1.GetDefaultDERPMapRequest, v1.GetDefaultDERPMapResponse]
+	getDERPHealth               *connect_go.Client[v1.GetDERPHealthRequest, v1.GetDERPHealthResponse]
 	createTailnet               *connect_go.Client[v1.CreateTailnetRequest, v1.CreateTailnetResponse]
 	updateTailnet               *connect_go.Client[v1.UpdateTailnetRequest, v1.UpdateTailnetResponse]
 	getTailnet                  *connect_go.Client[v1.GetTailnetRequest, v1.GetTailnetResponse]
@@ -460,8 +668,12 @@ type ionscaleServiceClient struct {
 	disableSSH                  *connect_go.Client[v1.DisableSSHRequest, v1.DisableSSHResponse]
 	enableMachineAuthorization  *connect_go.Client[v1.EnableMachineAuthorizationRequest, v1.EnableMachineAuthorizationResponse]
 	disableMachineAuthorization *connect_go.Client[v1.DisableMachineAuthorizationRequest, v1.DisableMachineAuthorizationResponse]
+	enableSCIM                  *connect_go.Client[v1.EnableSCIMRequest, v1.EnableSCIMResponse]
+	disableSCIM                 *connect_go.Client[v1.DisableSCIMRequest, v1.DisableSCIMResponse]
 	getDNSConfig                *connect_go.Client[v1.GetDNSConfigRequest, v1.GetDNSConfigResponse]
 	setDNSConfig                *connect_go.Client[v1.SetDNSConfigRequest, v1.SetDNSConfigResponse]
+	getAuthProviders            *connect_go.Client[v1.GetAuthProvidersRequest, v1.GetAuthProvidersResponse]
+	setAuthProviders            *connect_go.Client[v1.SetAuthProvidersRequest, v1.SetAuthProvidersResponse]
 	getIAMPolicy                *connect_go.Client[v1.GetIAMPolicyRequest, v1.GetIAMPolicyResponse]
 	setIAMPolicy                *connect_go.Client[v1.SetIAMPolicyRequest, v1.SetIAMPolicyResponse]
 	getACLPolicy                *connect_go.Client[v1.GetACLPolicyRequest, v1.GetACLPolicyResponse]
@@ -470,12 +682,22 @@ type ionscaleServiceClient struct {
 	createAuthKey               *connect_go.Client[v1.CreateAuthKeyRequest, v1.CreateAuthKeyResponse]
 	deleteAuthKey               *connect_go.Client[v1.DeleteAuthKeyRequest, v1.DeleteAuthKeyResponse]
 	listAuthKeys                *connect_go.Client[v1.ListAuthKeysRequest, v1.ListAuthKeysResponse]
+	createApiKey                *connect_go.Client[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse]
+	deleteApiKey                *connect_go.Client[v1.DeleteApiKeyRequest, v1.DeleteApiKeyResponse]
+	listApiKeys                 *connect_go.Client[v1.ListApiKeysRequest, v1.ListApiKeysResponse]
 	listUsers                   *connect_go.Client[v1.ListUsersRequest, v1.ListUsersResponse]
 	deleteUser                  *connect_go.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	suspendUser                 *connect_go.Client[v1.SuspendUserRequest, v1.SuspendUserResponse]
+	reactivateUser              *connect_go.Client[v1.ReactivateUserRequest, v1.ReactivateUserResponse]
+	createInvite                *connect_go.Client[v1.CreateInviteRequest, v1.CreateInviteResponse]
+	deleteInvite                *connect_go.Client[v1.DeleteInviteRequest, v1.DeleteInviteResponse]
+	listInvites                 *connect_go.Client[v1.ListInvitesRequest, v1.ListInvitesResponse]
 	getMachine                  *connect_go.Client[v1.GetMachineRequest, v1.GetMachineResponse]
 	listMachines                *connect_go.Client[v1.ListMachinesRequest, v1.ListMachinesResponse]
 	setMachineName              *connect_go.Client[v1.SetMachineNameRequest, v1.SetMachineNameResponse]
 	authorizeMachine            *connect_go.Client[v1.AuthorizeMachineRequest, v1.AuthorizeMachineResponse]
+	quarantineMachine           *connect_go.Client[v1.QuarantineMachineRequest, v1.QuarantineMachineResponse]
+	unquarantineMachine         *connect_go.Client[v1.UnquarantineMachineRequest, v1.UnquarantineMachineResponse]
 	expireMachine               *connect_go.Client[v1.ExpireMachineRequest, v1.ExpireMachineResponse]
 	deleteMachine               *connect_go.Client[v1.DeleteMachineRequest, v1.DeleteMachineResponse]
 	setMachineKeyExpiry         *connect_go.Client[v1.SetMachineKeyExpiryRequest, v1.SetMachineKeyExpiryResponse]
@@ -484,6 +706,14 @@ type ionscaleServiceClient struct {
 	disableMachineRoutes        *connect_go.Client[v1.DisableMachineRoutesRequest, v1.DisableMachineRoutesResponse]
 	enableExitNode              *connect_go.Client[v1.EnableExitNodeRequest, v1.EnableExitNodeResponse]
 	disableExitNode             *connect_go.Client[v1.DisableExitNodeRequest, v1.DisableExitNodeResponse]
+	shareMachine                *connect_go.Client[v1.ShareMachineRequest, v1.ShareMachineResponse]
+	listMachineShares           *connect_go.Client[v1.ListMachineSharesRequest, v1.ListMachineSharesResponse]
+	acceptMachineShare          *connect_go.Client[v1.AcceptMachineShareRequest, v1.AcceptMachineShareResponse]
+	revokeMachineShare          *connect_go.Client[v1.RevokeMachineShareRequest, v1.RevokeMachineShareResponse]
+	requestTemporaryGrant       *connect_go.Client[v1.RequestTemporaryGrantRequest, v1.RequestTemporaryGrantResponse]
+	approveTemporaryGrant       *connect_go.Client[v1.ApproveTemporaryGrantRequest, v1.ApproveTemporaryGrantResponse]
+	revokeTemporaryGrant        *connect_go.Client[v1.RevokeTemporaryGrantRequest, v1.RevokeTemporaryGrantResponse]
+	listTemporaryGrants         *connect_go.Client[v1.ListTemporaryGrantsRequest, v1.ListTemporaryGrantsResponse]
 }
 
 // GetVersion calls ionscale.v1.IonscaleService.GetVersion.
@@ -501,6 +731,11 @@ func (c *ionscaleServiceClient) GetDefaultDERPMap(ctx context.Context, req *conn
 	return c.getDefaultDERPMap.CallUnary(ctx, req)
 }
 
+// GetDERPHealth calls ionscale.v1.IonscaleService.GetDERPHealth.
+func (c *ionscaleServiceClient) GetDERPHealth(ctx context.Context, req *connect_go.Request[v1.GetDERPHealthRequest]) (*connect_go.Response[v1.GetDERPHealthResponse], error) {
+	return c.getDERPHealth.CallUnary(ctx, req)
+}
+
 // CreateTailnet calls ionscale.v1.IonscaleService.CreateTailnet.
 func (c *ionscaleServiceClient) CreateTailnet(ctx context.Context, req *connect_go.Request[v1.CreateTailnetRequest]) (*connect_go.Response[v1.CreateTailnetResponse], error) {
 	return c.createTailnet.CallUnary(ctx, req)
@@ -581,6 +816,16 @@ func (c *ionscaleServiceClient) DisableMachineAuthorization(ctx context.Context,
 	return c.disableMachineAuthorization.CallUnary(ctx, req)
 }
 
+// EnableSCIM calls ionscale.v1.IonscaleService.EnableSCIM.
+func (c *ionscaleServiceClient) EnableSCIM(ctx context.Context, req *connect_go.Request[v1.EnableSCIMRequest]) (*connect_go.Response[v1.EnableSCIMResponse], error) {
+	return c.enableSCIM.CallUnary(ctx, req)
+}
+
+// DisableSCIM calls ionscale.v1.IonscaleService.DisableSCIM.
+func (c *ionscaleServiceClient) DisableSCIM(ctx context.Context, req *connect_go.Request[v1.DisableSCIMRequest]) (*connect_go.Response[v1.DisableSCIMResponse], error) {
+	return c.disableSCIM.CallUnary(ctx, req)
+}
+
 // GetDNSConfig calls ionscale.v1.IonscaleService.GetDNSConfig.
 func (c *ionscaleServiceClient) GetDNSConfig(ctx context.Context, req *connect_go.Request[v1.GetDNSConfigRequest]) (*connect_go.Response[v1.GetDNSConfigResponse], error) {
 	return c.getDNSConfig.CallUnary(ctx, req)
@@ -591,6 +836,16 @@ func (c *ionscaleServiceClient) SetDNSConfig(ctx context.Context, req *connect_g
 	return c.setDNSConfig.CallUnary(ctx, req)
 }
 
+// GetAuthProviders calls ionscale.v1.IonscaleService.GetAuthProviders.
+func (c *ionscaleServiceClient) GetAuthProviders(ctx context.Context, req *connect_go.Request[v1.GetAuthProvidersRequest]) (*connect_go.Response[v1.GetAuthProvidersResponse], error) {
+	return c.getAuthProviders.CallUnary(ctx, req)
+}
+
+// SetAuthProviders calls ionscale.v1.IonscaleService.SetAuthProviders.
+func (c *ionscaleServiceClient) SetAuthProviders(ctx context.Context, req *connect_go.Request[v1.SetAuthProvidersRequest]) (*connect_go.Response[v1.SetAuthProvidersResponse], error) {
+	return c.setAuthProviders.CallUnary(ctx, req)
+}
+
 // GetIAMPolicy calls ionscale.v1.IonscaleService.GetIAMPolicy.
 func (c *ionscaleServiceClient) GetIAMPolicy(ctx context.Context, req *connect_go.Request[v1.GetIAMPolicyRequest]) (*connect_go.Response[v1.GetIAMPolicyResponse], error) {
 	return c.getIAMPolicy.CallUnary(ctx, req)
@@ -631,6 +886,21 @@ func (c *ionscaleServiceClient) ListAuthKeys(ctx context.Context, req *connect_g
 	return c.listAuthKeys.CallUnary(ctx, req)
 }
 
+// CreateApiKey calls ionscale.v1.IonscaleService.CreateApiKey.
+func (c *ionscaleServiceClient) CreateApiKey(ctx context.Context, req *connect_go.Request[v1.CreateApiKeyRequest]) (*connect_go.Response[v1.CreateApiKeyResponse], error) {
+	return c.createApiKey.CallUnary(ctx, req)
+}
+
+// DeleteApiKey calls ionscale.v1.IonscaleService.DeleteApiKey.
+func (c *ionscaleServiceClient) DeleteApiKey(ctx context.Context, req *connect_go.Request[v1.DeleteApiKeyRequest]) (*connect_go.Response[v1.DeleteApiKeyResponse], error) {
+	return c.deleteApiKey.CallUnary(ctx, req)
+}
+
+// ListApiKeys calls ionscale.v1.IonscaleService.ListApiKeys.
+func (c *ionscaleServiceClient) ListApiKeys(ctx context.Context, req *connect_go.Request[v1.ListApiKeysRequest]) (*connect_go.Response[v1.ListApiKeysResponse], error) {
+	return c.listApiKeys.CallUnary(ctx, req)
+}
+
 // ListUsers calls ionscale.v1.IonscaleService.ListUsers.
 func (c *ionscaleServiceClient) ListUsers(ctx context.Context, req *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error) {
 	return c.listUsers.CallUnary(ctx, req)
@@ -641,6 +911,31 @@ func (c *ionscaleServiceClient) DeleteUser(ctx context.Context, req *connect_go.
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// SuspendUser calls ionscale.v1.IonscaleService.SuspendUser.
+func (c *ionscaleServiceClient) SuspendUser(ctx context.Context, req *connect_go.Request[v1.SuspendUserRequest]) (*connect_go.Response[v1.SuspendUserResponse], error) {
+	return c.suspendUser.CallUnary(ctx, req)
+}
+
+// ReactivateUser calls ionscale.v1.IonscaleService.ReactivateUser.
+func (c *ionscaleServiceClient) ReactivateUser(ctx context.Context, req *connect_go.Request[v1.ReactivateUserRequest]) (*connect_go.Response[v1.ReactivateUserResponse], error) {
+	return c.reactivateUser.CallUnary(ctx, req)
+}
+
+// CreateInvite calls ionscale.v1.IonscaleService.CreateInvite.
+func (c *ionscaleServiceClient) CreateInvite(ctx context.Context, req *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error) {
+	return c.createInvite.CallUnary(ctx, req)
+}
+
+// DeleteInvite calls ionscale.v1.IonscaleService.DeleteInvite.
+func (c *ionscaleServiceClient) DeleteInvite(ctx context.Context, req *connect_go.Request[v1.DeleteInviteRequest]) (*connect_go.Response[v1.DeleteInviteResponse], error) {
+	return c.deleteInvite.CallUnary(ctx, req)
+}
+
+// ListInvites calls ionscale.v1.IonscaleService.ListInvites.
+func (c *ionscaleServiceClient) ListInvites(ctx context.Context, req *connect_go.Request[v1.ListInvitesRequest]) (*connect_go.Response[v1.ListInvitesResponse], error) {
+	return c.listInvites.CallUnary(ctx, req)
+}
+
 // GetMachine calls ionscale.v1.IonscaleService.GetMachine.
 func (c *ionscaleServiceClient) GetMachine(ctx context.Context, req *connect_go.Request[v1.GetMachineRequest]) (*connect_go.Response[v1.GetMachineResponse], error) {
 	return c.getMachine.CallUnary(ctx, req)
@@ -661,6 +956,16 @@ func (c *ionscaleServiceClient) AuthorizeMachine(ctx context.Context, req *conne
 	return c.authorizeMachine.CallUnary(ctx, req)
 }
 
+// QuarantineMachine calls ionscale.v1.IonscaleService.QuarantineMachine.
+func (c *ionscaleServiceClient) QuarantineMachine(ctx context.Context, req *connect_go.Request[v1.QuarantineMachineRequest]) (*connect_go.Response[v1.QuarantineMachineResponse], error) {
+	return c.quarantineMachine.CallUnary(ctx, req)
+}
+
+// UnquarantineMachine calls ionscale.v1.IonscaleService.UnquarantineMachine.
+func (c *ionscaleServiceClient) UnquarantineMachine(ctx context.Context, req *connect_go.Request[v1.UnquarantineMachineRequest]) (*connect_go.Response[v1.UnquarantineMachineResponse], error) {
+	return c.unquarantineMachine.CallUnary(ctx, req)
+}
+
 // ExpireMachine calls ionscale.v1.IonscaleService.ExpireMachine.
 func (c *ionscaleServiceClient) ExpireMachine(ctx context.Context, req *connect_go.Request[v1.ExpireMachineRequest]) (*connect_go.Response[v1.ExpireMachineResponse], error) {
 	return c.expireMachine.CallUnary(ctx, req)
@@ -701,11 +1006,52 @@ func (c *ionscaleServiceClient) DisableExitNode(ctx context.Context, req *connec
 	return c.disableExitNode.CallUnary(ctx, req)
 }
 
+// ShareMachine calls ionscale.v1.IonscaleService.ShareMachine.
+func (c *ionscaleServiceClient) ShareMachine(ctx context.Context, req *connect_go.Request[v1.ShareMachineRequest]) (*connect_go.Response[v1.ShareMachineResponse], error) {
+	return c.shareMachine.CallUnary(ctx, req)
+}
+
+// ListMachineShares calls ionscale.v1.IonscaleService.ListMachineShares.
+func (c *ionscaleServiceClient) ListMachineShares(ctx context.Context, req *connect_go.Request[v1.ListMachineSharesRequest]) (*connect_go.Response[v1.ListMachineSharesResponse], error) {
+	return c.listMachineShares.CallUnary(ctx, req)
+}
+
+// AcceptMachineShare calls ionscale.v1.IonscaleService.AcceptMachineShare.
+func (c *ionscaleServiceClient) AcceptMachineShare(ctx context.Context, req *connect_go.Request[v1.AcceptMachineShareRequest]) (*connect_go.Response[v1.AcceptMachineShareResponse], error) {
+	return c.acceptMachineShare.CallUnary(ctx, req)
+}
+
+// RevokeMachineShare calls ionscale.v1.IonscaleService.RevokeMachineShare.
+func (c *ionscaleServiceClient) RevokeMachineShare(ctx context.Context, req *connect_go.Request[v1.RevokeMachineShareRequest]) (*connect_go.Response[v1.RevokeMachineShareResponse], error) {
+	return c.revokeMachineShare.CallUnary(ctx, req)
+}
+
+// RequestTemporaryGrant calls ionscale.v1.IonscaleService.RequestTemporaryGrant.
+func (c *ionscaleServiceClient) RequestTemporaryGrant(ctx context.Context, req *connect_go.Request[v1.RequestTemporaryGrantRequest]) (*connect_go.Response[v1.RequestTemporaryGrantResponse], error) {
+	return c.requestTemporaryGrant.CallUnary(ctx, req)
+}
+
+// ApproveTemporaryGrant calls ionscale.v1.IonscaleService.ApproveTemporaryGrant.
+func (c *ionscaleServiceClient) ApproveTemporaryGrant(ctx context.Context, req *connect_go.Request[v1.ApproveTemporaryGrantRequest]) (*connect_go.Response[v1.ApproveTemporaryGrantResponse], error) {
+	return c.approveTemporaryGrant.CallUnary(ctx, req)
+}
+
+// RevokeTemporaryGrant calls ionscale.v1.IonscaleService.RevokeTemporaryGrant.
+func (c *ionscaleServiceClient) RevokeTemporaryGrant(ctx context.Context, req *connect_go.Request[v1.RevokeTemporaryGrantRequest]) (*connect_go.Response[v1.RevokeTemporaryGrantResponse], error) {
+	return c.revokeTemporaryGrant.CallUnary(ctx, req)
+}
+
+// ListTemporaryGrants calls ionscale.v1.IonscaleService.ListTemporaryGrants.
+func (c *ionscaleServiceClient) ListTemporaryGrants(ctx context.Context, req *connect_go.Request[v1.ListTemporaryGrantsRequest]) (*connect_go.Response[v1.ListTemporaryGrantsResponse], error) {
+	return c.listTemporaryGrants.CallUnary(ctx, req)
+}
+
 // IonscaleServiceHandler is an implementation of the ionscale.v1.IonscaleService service.
 type IonscaleServiceHandler interface {
 	GetVersion(context.Context, *connect_go.Request[v1.GetVersionRequest]) (*connect_go.Response[v1.GetVersionResponse], error)
 	Authenticate(context.Context, *connect_go.Request[v1.AuthenticateRequest], *connect_go.ServerStream[v1.AuthenticateResponse]) error
 	GetDefaultDERPMap(context.Context, *connect_go.Request[v1.GetDefaultDERPMapRequest]) (*connect_go.Response[v1.GetDefaultDERPMapResponse], error)
+	GetDERPHealth(context.Context, *connect_go.Request[v1.GetDERPHealthRequest]) (*connect_go.Response[v1.GetDERPHealthResponse], error)
 	CreateTailnet(context.Context, *connect_go.Request[v1.CreateTailnetRequest]) (*connect_go.Response[v1.CreateTailnetResponse], error)
 	UpdateTailnet(context.Context, *connect_go.Request[v1.UpdateTailnetRequest]) (*connect_go.Response[v1.UpdateTailnetResponse], error)
 	GetTailnet(context.Context, *connect_go.Request[v1.GetTailnetRequest]) (*connect_go.Response[v1.GetTailnetResponse], error)
@@ -722,8 +1068,12 @@ type IonscaleServiceHandler interface {
 	DisableSSH(context.Context, *connect_go.Request[v1.DisableSSHRequest]) (*connect_go.Response[v1.DisableSSHResponse], error)
 	EnableMachineAuthorization(context.Context, *connect_go.Request[v1.EnableMachineAuthorizationRequest]) (*connect_go.Response[v1.EnableMachineAuthorizationResponse], error)
 	DisableMachineAuthorization(context.Context, *connect_go.Request[v1.DisableMachineAuthorizationRequest]) (*connect_go.Response[v1.DisableMachineAuthorizationResponse], error)
+	EnableSCIM(context.Context, *connect_go.Request[v1.EnableSCIMRequest]) (*connect_go.Response[v1.EnableSCIMResponse], error)
+	DisableSCIM(context.Context, *connect_go.Request[v1.DisableSCIMRequest]) (*connect_go.Response[v1.DisableSCIMResponse], error)
 	GetDNSConfig(context.Context, *connect_go.Request[v1.GetDNSConfigRequest]) (*connect_go.Response[v1.GetDNSConfigResponse], error)
 	SetDNSConfig(context.Context, *connect_go.Request[v1.SetDNSConfigRequest]) (*connect_go.Response[v1.SetDNSConfigResponse], error)
+	GetAuthProviders(context.Context, *connect_go.Request[v1.GetAuthProvidersRequest]) (*connect_go.Response[v1.GetAuthProvidersResponse], error)
+	SetAuthProviders(context.Context, *connect_go.Request[v1.SetAuthProvidersRequest]) (*connect_go.Response[v1.SetAuthProvidersResponse], error)
 	GetIAMPolicy(context.Context, *connect_go.Request[v1.GetIAMPolicyRequest]) (*connect_go.Response[v1.GetIAMPolicyResponse], error)
 	SetIAMPolicy(context.Context, *connect_go.Request[v1.SetIAMPolicyRequest]) (*connect_go.Response[v1.SetIAMPolicyResponse], error)
 	GetACLPolicy(context.Context, *connect_go.Request[v1.GetACLPolicyRequest]) (*connect_go.Response[v1.GetACLPolicyResponse], error)
@@ -732,12 +1082,22 @@ type IonscaleServiceHandler interface {
 	CreateAuthKey(context.Context, *connect_go.Request[v1.CreateAuthKeyRequest]) (*connect_go.Response[v1.CreateAuthKeyResponse], error)
 	DeleteAuthKey(context.Context, *connect_go.Request[v1.DeleteAuthKeyRequest]) (*connect_go.Response[v1.DeleteAuthKeyResponse], error)
 	ListAuthKeys(context.Context, *connect_go.Request[v1.ListAuthKeysRequest]) (*connect_go.Response[v1.ListAuthKeysResponse], error)
+	CreateApiKey(context.Context, *connect_go.Request[v1.CreateApiKeyRequest]) (*connect_go.Response[v1.CreateApiKeyResponse], error)
+	DeleteApiKey(context.Context, *connect_go.Request[v1.DeleteApiKeyRequest]) (*connect_go.Response[v1.DeleteApiKeyResponse], error)
+	ListApiKeys(context.Context, *connect_go.Request[v1.ListApiKeysRequest]) (*connect_go.Response[v1.ListApiKeysResponse], error)
 	ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error)
 	DeleteUser(context.Context, *connect_go.Request[v1.DeleteUserRequest]) (*connect_go.Response[v1.DeleteUserResponse], error)
+	SuspendUser(context.Context, *connect_go.Request[v1.SuspendUserRequest]) (*connect_go.Response[v1.SuspendUserResponse], error)
+	ReactivateUser(context.Context, *connect_go.Request[v1.ReactivateUserRequest]) (*connect_go.Response[v1.ReactivateUserResponse], error)
+	CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error)
+	DeleteInvite(context.Context, *connect_go.Request[v1.DeleteInviteRequest]) (*connect_go.Response[v1.DeleteInviteResponse], error)
+	ListInvites(context.Context, *connect_go.Request[v1.ListInvitesRequest]) (*connect_go.Response[v1.ListInvitesResponse], error)
 	GetMachine(context.Context, *connect_go.Request[v1.GetMachineRequest]) (*connect_go.Response[v1.GetMachineResponse], error)
 	ListMachines(context.Context, *connect_go.Request[v1.ListMachinesRequest]) (*connect_go.Response[v1.ListMachinesResponse], error)
 	SetMachineName(context.Context, *connect_go.Request[v1.SetMachineNameRequest]) (*connect_go.Response[v1.SetMachineNameResponse], error)
 	AuthorizeMachine(context.Context, *connect_go.Request[v1.AuthorizeMachineRequest]) (*connect_go.Response[v1.AuthorizeMachineResponse], error)
+	QuarantineMachine(context.Context, *connect_go.Request[v1.QuarantineMachineRequest]) (*connect_go.Response[v1.QuarantineMachineResponse], error)
+	UnquarantineMachine(context.Context, *connect_go.Request[v1.UnquarantineMachineRequest]) (*connect_go.Response[v1.UnquarantineMachineResponse], error)
 	ExpireMachine(context.Context, *connect_go.Request[v1.ExpireMachineRequest]) (*connect_go.Response[v1.ExpireMachineResponse], error)
 	DeleteMachine(context.Context, *connect_go.Request[v1.DeleteMachineRequest]) (*connect_go.Response[v1.DeleteMachineResponse], error)
 	SetMachineKeyExpiry(context.Context, *connect_go.Request[v1.SetMachineKeyExpiryRequest]) (*connect_go.Response[v1.SetMachineKeyExpiryResponse], error)
@@ -746,6 +1106,14 @@ type IonscaleServiceHandler interface {
 	DisableMachineRoutes(context.Context, *connect_go.Request[v1.DisableMachineRoutesRequest]) (*connect_go.Response[v1.DisableMachineRoutesResponse], error)
 	EnableExitNode(context.Context, *connect_go.Request[v1.EnableExitNodeRequest]) (*connect_go.Response[v1.EnableExitNodeResponse], error)
 	DisableExitNode(context.Context, *connect_go.Request[v1.DisableExitNodeRequest]) (*connect_go.Response[v1.DisableExitNodeResponse], error)
+	ShareMachine(context.Context, *connect_go.Request[v1.ShareMachineRequest]) (*connect_go.Response[v1.ShareMachineResponse], error)
+	ListMachineShares(context.Context, *connect_go.Request[v1.ListMachineSharesRequest]) (*connect_go.Response[v1.ListMachineSharesResponse], error)
+	AcceptMachineShare(context.Context, *connect_go.Request[v1.AcceptMachineShareRequest]) (*connect_go.Response[v1.AcceptMachineShareResponse], error)
+	RevokeMachineShare(context.Context, *connect_go.Request[v1.RevokeMachineShareRequest]) (*connect_go.Response[v1.RevokeMachineShareResponse], error)
+	RequestTemporaryGrant(context.Context, *connect_go.Request[v1.RequestTemporaryGrantRequest]) (*connect_go.Response[v1.RequestTemporaryGrantResponse], error)
+	ApproveTemporaryGrant(context.Context, *connect_go.Request[v1.ApproveTemporaryGrantRequest]) (*connect_go.Response[v1.ApproveTemporaryGrantResponse], error)
+	RevokeTemporaryGrant(context.Context, *connect_go.Request[v1.RevokeTemporaryGrantRequest]) (*connect_go.Response[v1.RevokeTemporaryGrantResponse], error)
+	ListTemporaryGrants(context.Context, *connect_go.Request[v1.ListTemporaryGrantsRequest]) (*connect_go.Response[v1.ListTemporaryGrantsResponse], error)
 }
 
 // NewIonscaleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -769,6 +1137,11 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.GetDefaultDERPMap,
 		opts...,
 	)
+	ionscaleServiceGetDERPHealthHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceGetDERPHealthProcedure,
+		svc.GetDERPHealth,
+		opts...,
+	)
 	ionscaleServiceCreateTailnetHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceCreateTailnetProcedure,
 		svc.CreateTailnet,
@@ -849,6 +1222,16 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.DisableMachineAuthorization,
 		opts...,
 	)
+	ionscaleServiceEnableSCIMHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceEnableSCIMProcedure,
+		svc.EnableSCIM,
+		opts...,
+	)
+	ionscaleServiceDisableSCIMHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceDisableSCIMProcedure,
+		svc.DisableSCIM,
+		opts...,
+	)
 	ionscaleServiceGetDNSConfigHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceGetDNSConfigProcedure,
 		svc.GetDNSConfig,
@@ -859,6 +1242,16 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.SetDNSConfig,
 		opts...,
 	)
+	ionscaleServiceGetAuthProvidersHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceGetAuthProvidersProcedure,
+		svc.GetAuthProviders,
+		opts...,
+	)
+	ionscaleServiceSetAuthProvidersHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceSetAuthProvidersProcedure,
+		svc.SetAuthProviders,
+		opts...,
+	)
 	ionscaleServiceGetIAMPolicyHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceGetIAMPolicyProcedure,
 		svc.GetIAMPolicy,
@@ -899,6 +1292,21 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.ListAuthKeys,
 		opts...,
 	)
+	ionscaleServiceCreateApiKeyHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceCreateApiKeyProcedure,
+		svc.CreateApiKey,
+		opts...,
+	)
+	ionscaleServiceDeleteApiKeyHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceDeleteApiKeyProcedure,
+		svc.DeleteApiKey,
+		opts...,
+	)
+	ionscaleServiceListApiKeysHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceListApiKeysProcedure,
+		svc.ListApiKeys,
+		opts...,
+	)
 	ionscaleServiceListUsersHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceListUsersProcedure,
 		svc.ListUsers,
@@ -909,6 +1317,31 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.DeleteUser,
 		opts...,
 	)
+	ionscaleServiceSuspendUserHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceSuspendUserProcedure,
+		svc.SuspendUser,
+		opts...,
+	)
+	ionscaleServiceReactivateUserHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceReactivateUserProcedure,
+		svc.ReactivateUser,
+		opts...,
+	)
+	ionscaleServiceCreateInviteHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceCreateInviteProcedure,
+		svc.CreateInvite,
+		opts...,
+	)
+	ionscaleServiceDeleteInviteHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceDeleteInviteProcedure,
+		svc.DeleteInvite,
+		opts...,
+	)
+	ionscaleServiceListInvitesHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceListInvitesProcedure,
+		svc.ListInvites,
+		opts...,
+	)
 	ionscaleServiceGetMachineHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceGetMachineProcedure,
 		svc.GetMachine,
@@ -929,6 +1362,16 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.AuthorizeMachine,
 		opts...,
 	)
+	ionscaleServiceQuarantineMachineHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceQuarantineMachineProcedure,
+		svc.QuarantineMachine,
+		opts...,
+	)
+	ionscaleServiceUnquarantineMachineHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceUnquarantineMachineProcedure,
+		svc.UnquarantineMachine,
+		opts...,
+	)
 	ionscaleServiceExpireMachineHandler := connect_go.NewUnaryHandler(
 		IonscaleServiceExpireMachineProcedure,
 		svc.ExpireMachine,
@@ -969,6 +1412,46 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 		svc.DisableExitNode,
 		opts...,
 	)
+	ionscaleServiceShareMachineHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceShareMachineProcedure,
+		svc.ShareMachine,
+		opts...,
+	)
+	ionscaleServiceListMachineSharesHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceListMachineSharesProcedure,
+		svc.ListMachineShares,
+		opts...,
+	)
+	ionscaleServiceAcceptMachineShareHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceAcceptMachineShareProcedure,
+		svc.AcceptMachineShare,
+		opts...,
+	)
+	ionscaleServiceRevokeMachineShareHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceRevokeMachineShareProcedure,
+		svc.RevokeMachineShare,
+		opts...,
+	)
+	ionscaleServiceRequestTemporaryGrantHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceRequestTemporaryGrantProcedure,
+		svc.RequestTemporaryGrant,
+		opts...,
+	)
+	ionscaleServiceApproveTemporaryGrantHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceApproveTemporaryGrantProcedure,
+		svc.ApproveTemporaryGrant,
+		opts...,
+	)
+	ionscaleServiceRevokeTemporaryGrantHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceRevokeTemporaryGrantProcedure,
+		svc.RevokeTemporaryGrant,
+		opts...,
+	)
+	ionscaleServiceListTemporaryGrantsHandler := connect_go.NewUnaryHandler(
+		IonscaleServiceListTemporaryGrantsProcedure,
+		svc.ListTemporaryGrants,
+		opts...,
+	)
 	return "/ionscale.v1.IonscaleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IonscaleServiceGetVersionProcedure:
@@ -977,6 +1460,8 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 			ionscaleServiceAuthenticateHandler.ServeHTTP(w, r)
 		case IonscaleServiceGetDefaultDERPMapProcedure:
 			ionscaleServiceGetDefaultDERPMapHandler.ServeHTTP(w, r)
+		case IonscaleServiceGetDERPHealthProcedure:
+			ionscaleServiceGetDERPHealthHandler.ServeHTTP(w, r)
 		case IonscaleServiceCreateTailnetProcedure:
 			ionscaleServiceCreateTailnetHandler.ServeHTTP(w, r)
 		case IonscaleServiceUpdateTailnetProcedure:
@@ -1009,10 +1494,18 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 			ionscaleServiceEnableMachineAuthorizationHandler.ServeHTTP(w, r)
 		case IonscaleServiceDisableMachineAuthorizationProcedure:
 			ionscaleServiceDisableMachineAuthorizationHandler.ServeHTTP(w, r)
+		case IonscaleServiceEnableSCIMProcedure:
+			ionscaleServiceEnableSCIMHandler.ServeHTTP(w, r)
+		case IonscaleServiceDisableSCIMProcedure:
+			ionscaleServiceDisableSCIMHandler.ServeHTTP(w, r)
 		case IonscaleServiceGetDNSConfigProcedure:
 			ionscaleServiceGetDNSConfigHandler.ServeHTTP(w, r)
 		case IonscaleServiceSetDNSConfigProcedure:
 			ionscaleServiceSetDNSConfigHandler.ServeHTTP(w, r)
+		case IonscaleServiceGetAuthProvidersProcedure:
+			ionscaleServiceGetAuthProvidersHandler.ServeHTTP(w, r)
+		case IonscaleServiceSetAuthProvidersProcedure:
+			ionscaleServiceSetAuthProvidersHandler.ServeHTTP(w, r)
 		case IonscaleServiceGetIAMPolicyProcedure:
 			ionscaleServiceGetIAMPolicyHandler.ServeHTTP(w, r)
 		case IonscaleServiceSetIAMPolicyProcedure:
@@ -1029,10 +1522,26 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 			ionscaleServiceDeleteAuthKeyHandler.ServeHTTP(w, r)
 		case IonscaleServiceListAuthKeysProcedure:
 			ionscaleServiceListAuthKeysHandler.ServeHTTP(w, r)
+		case IonscaleServiceCreateApiKeyProcedure:
+			ionscaleServiceCreateApiKeyHandler.ServeHTTP(w, r)
+		case IonscaleServiceDeleteApiKeyProcedure:
+			ionscaleServiceDeleteApiKeyHandler.ServeHTTP(w, r)
+		case IonscaleServiceListApiKeysProcedure:
+			ionscaleServiceListApiKeysHandler.ServeHTTP(w, r)
 		case IonscaleServiceListUsersProcedure:
 			ionscaleServiceListUsersHandler.ServeHTTP(w, r)
 		case IonscaleServiceDeleteUserProcedure:
 			ionscaleServiceDeleteUserHandler.ServeHTTP(w, r)
+		case IonscaleServiceSuspendUserProcedure:
+			ionscaleServiceSuspendUserHandler.ServeHTTP(w, r)
+		case IonscaleServiceReactivateUserProcedure:
+			ionscaleServiceReactivateUserHandler.ServeHTTP(w, r)
+		case IonscaleServiceCreateInviteProcedure:
+			ionscaleServiceCreateInviteHandler.ServeHTTP(w, r)
+		case IonscaleServiceDeleteInviteProcedure:
+			ionscaleServiceDeleteInviteHandler.ServeHTTP(w, r)
+		case IonscaleServiceListInvitesProcedure:
+			ionscaleServiceListInvitesHandler.ServeHTTP(w, r)
 		case IonscaleServiceGetMachineProcedure:
 			ionscaleServiceGetMachineHandler.ServeHTTP(w, r)
 		case IonscaleServiceListMachinesProcedure:
@@ -1041,6 +1550,10 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 			ionscaleServiceSetMachineNameHandler.ServeHTTP(w, r)
 		case IonscaleServiceAuthorizeMachineProcedure:
 			ionscaleServiceAuthorizeMachineHandler.ServeHTTP(w, r)
+		case IonscaleServiceQuarantineMachineProcedure:
+			ionscaleServiceQuarantineMachineHandler.ServeHTTP(w, r)
+		case IonscaleServiceUnquarantineMachineProcedure:
+			ionscaleServiceUnquarantineMachineHandler.ServeHTTP(w, r)
 		case IonscaleServiceExpireMachineProcedure:
 			ionscaleServiceExpireMachineHandler.ServeHTTP(w, r)
 		case IonscaleServiceDeleteMachineProcedure:
@@ -1057,6 +1570,22 @@ func NewIonscaleServiceHandler(svc IonscaleServiceHandler, opts ...connect_go.Ha
 			ionscaleServiceEnableExitNodeHandler.ServeHTTP(w, r)
 		case IonscaleServiceDisableExitNodeProcedure:
 			ionscaleServiceDisableExitNodeHandler.ServeHTTP(w, r)
+		case IonscaleServiceShareMachineProcedure:
+			ionscaleServiceShareMachineHandler.ServeHTTP(w, r)
+		case IonscaleServiceListMachineSharesProcedure:
+			ionscaleServiceListMachineSharesHandler.ServeHTTP(w, r)
+		case IonscaleServiceAcceptMachineShareProcedure:
+			ionscaleServiceAcceptMachineShareHandler.ServeHTTP(w, r)
+		case IonscaleServiceRevokeMachineShareProcedure:
+			ionscaleServiceRevokeMachineShareHandler.ServeHTTP(w, r)
+		case IonscaleServiceRequestTemporaryGrantProcedure:
+			ionscaleServiceRequestTemporaryGrantHandler.ServeHTTP(w, r)
+		case IonscaleServiceApproveTemporaryGrantProcedure:
+			ionscaleServiceApproveTemporaryGrantHandler.ServeHTTP(w, r)
+		case IonscaleServiceRevokeTemporaryGrantProcedure:
+			ionscaleServiceRevokeTemporaryGrantHandler.ServeHTTP(w, r)
+		case IonscaleServiceListTemporaryGrantsProcedure:
+			ionscaleServiceListTemporaryGrantsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1078,6 +1607,10 @@ func (UnimplementedIonscaleServiceHandler) GetDefaultDERPMap(context.Context, *c
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetDefaultDERPMap is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) GetDERPHealth(context.Context, *connect_go.Request[v1.GetDERPHealthRequest]) (*connect_go.Response[v1.GetDERPHealthResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetDERPHealth is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) CreateTailnet(context.Context, *connect_go.Request[v1.CreateTailnetRequest]) (*connect_go.Response[v1.CreateTailnetResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.CreateTailnet is not implemented"))
 }
@@ -1142,6 +1675,14 @@ func (UnimplementedIonscaleServiceHandler) DisableMachineAuthorization(context.C
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DisableMachineAuthorization is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) EnableSCIM(context.Context, *connect_go.Request[v1.EnableSCIMRequest]) (*connect_go.Response[v1.EnableSCIMResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.EnableSCIM is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) DisableSCIM(context.Context, *connect_go.Request[v1.DisableSCIMRequest]) (*connect_go.Response[v1.DisableSCIMResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DisableSCIM is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) GetDNSConfig(context.Context, *connect_go.Request[v1.GetDNSConfigRequest]) (*connect_go.Response[v1.GetDNSConfigResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetDNSConfig is not implemented"))
 }
@@ -1150,6 +1691,14 @@ func (UnimplementedIonscaleServiceHandler) SetDNSConfig(context.Context, *connec
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.SetDNSConfig is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) GetAuthProviders(context.Context, *connect_go.Request[v1.GetAuthProvidersRequest]) (*connect_go.Response[v1.GetAuthProvidersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetAuthProviders is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) SetAuthProviders(context.Context, *connect_go.Request[v1.SetAuthProvidersRequest]) (*connect_go.Response[v1.SetAuthProvidersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.SetAuthProviders is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) GetIAMPolicy(context.Context, *connect_go.Request[v1.GetIAMPolicyRequest]) (*connect_go.Response[v1.GetIAMPolicyResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetIAMPolicy is not implemented"))
 }
@@ -1182,6 +1731,18 @@ func (UnimplementedIonscaleServiceHandler) ListAuthKeys(context.Context, *connec
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListAuthKeys is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) CreateApiKey(context.Context, *connect_go.Request[v1.CreateApiKeyRequest]) (*connect_go.Response[v1.CreateApiKeyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.CreateApiKey is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) DeleteApiKey(context.Context, *connect_go.Request[v1.DeleteApiKeyRequest]) (*connect_go.Response[v1.DeleteApiKeyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DeleteApiKey is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ListApiKeys(context.Context, *connect_go.Request[v1.ListApiKeysRequest]) (*connect_go.Response[v1.ListApiKeysResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListApiKeys is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListUsers is not implemented"))
 }
@@ -1190,6 +1751,26 @@ func (UnimplementedIonscaleServiceHandler) DeleteUser(context.Context, *connect_
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) SuspendUser(context.Context, *connect_go.Request[v1.SuspendUserRequest]) (*connect_go.Response[v1.SuspendUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.SuspendUser is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ReactivateUser(context.Context, *connect_go.Request[v1.ReactivateUserRequest]) (*connect_go.Response[v1.ReactivateUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ReactivateUser is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.CreateInvite is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) DeleteInvite(context.Context, *connect_go.Request[v1.DeleteInviteRequest]) (*connect_go.Response[v1.DeleteInviteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DeleteInvite is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ListInvites(context.Context, *connect_go.Request[v1.ListInvitesRequest]) (*connect_go.Response[v1.ListInvitesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListInvites is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) GetMachine(context.Context, *connect_go.Request[v1.GetMachineRequest]) (*connect_go.Response[v1.GetMachineResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.GetMachine is not implemented"))
 }
@@ -1206,6 +1787,14 @@ func (UnimplementedIonscaleServiceHandler) AuthorizeMachine(context.Context, *co
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.AuthorizeMachine is not implemented"))
 }
 
+func (UnimplementedIonscaleServiceHandler) QuarantineMachine(context.Context, *connect_go.Request[v1.QuarantineMachineRequest]) (*connect_go.Response[v1.QuarantineMachineResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.QuarantineMachine is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) UnquarantineMachine(context.Context, *connect_go.Request[v1.UnquarantineMachineRequest]) (*connect_go.Response[v1.UnquarantineMachineResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.UnquarantineMachine is not implemented"))
+}
+
 func (UnimplementedIonscaleServiceHandler) ExpireMachine(context.Context, *connect_go.Request[v1.ExpireMachineRequest]) (*connect_go.Response[v1.ExpireMachineResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ExpireMachine is not implemented"))
 }
@@ -1237,3 +1826,35 @@ func (UnimplementedIonscaleServiceHandler) EnableExitNode(context.Context, *conn
 func (UnimplementedIonscaleServiceHandler) DisableExitNode(context.Context, *connect_go.Request[v1.DisableExitNodeRequest]) (*connect_go.Response[v1.DisableExitNodeResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.DisableExitNode is not implemented"))
 }
+
+func (UnimplementedIonscaleServiceHandler) ShareMachine(context.Context, *connect_go.Request[v1.ShareMachineRequest]) (*connect_go.Response[v1.ShareMachineResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ShareMachine is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ListMachineShares(context.Context, *connect_go.Request[v1.ListMachineSharesRequest]) (*connect_go.Response[v1.ListMachineSharesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListMachineShares is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) AcceptMachineShare(context.Context, *connect_go.Request[v1.AcceptMachineShareRequest]) (*connect_go.Response[v1.AcceptMachineShareResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.AcceptMachineShare is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) RevokeMachineShare(context.Context, *connect_go.Request[v1.RevokeMachineShareRequest]) (*connect_go.Response[v1.RevokeMachineShareResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.RevokeMachineShare is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) RequestTemporaryGrant(context.Context, *connect_go.Request[v1.RequestTemporaryGrantRequest]) (*connect_go.Response[v1.RequestTemporaryGrantResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.RequestTemporaryGrant is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ApproveTemporaryGrant(context.Context, *connect_go.Request[v1.ApproveTemporaryGrantRequest]) (*connect_go.Response[v1.ApproveTemporaryGrantResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ApproveTemporaryGrant is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) RevokeTemporaryGrant(context.Context, *connect_go.Request[v1.RevokeTemporaryGrantRequest]) (*connect_go.Response[v1.RevokeTemporaryGrantResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.RevokeTemporaryGrant is not implemented"))
+}
+
+func (UnimplementedIonscaleServiceHandler) ListTemporaryGrants(context.Context, *connect_go.Request[v1.ListTemporaryGrantsRequest]) (*connect_go.Response[v1.ListTemporaryGrantsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ionscale.v1.IonscaleService.ListTemporaryGrants is not implemented"))
+}
@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: ionscale/v1/shares.proto
+
+package ionscalev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShareMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     uint64                 `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Tailnet       string                 `protobuf:"bytes,2,opt,name=tailnet,proto3" json:"tailnet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareMachineRequest) Reset() {
+	*x = ShareMachineRequest{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMachineRequest) ProtoMessage() {}
+
+func (x *ShareMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMachineRequest.ProtoReflect.Descriptor instead.
+func (*ShareMachineRequest) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShareMachineRequest) GetMachineId() uint64 {
+	if x != nil {
+		return x.MachineId
+	}
+	return 0
+}
+
+func (x *ShareMachineRequest) GetTailnet() string {
+	if x != nil {
+		return x.Tailnet
+	}
+	return ""
+}
+
+type ShareMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         *MachineShare          `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareMachineResponse) Reset() {
+	*x = ShareMachineResponse{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMachineResponse) ProtoMessage() {}
+
+func (x *ShareMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMachineResponse.ProtoReflect.Descriptor instead.
+func (*ShareMachineResponse) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShareMachineResponse) GetShare() *MachineShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type ListMachineSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TailnetId     uint64                 `protobuf:"varint,1,opt,name=tailnet_id,json=tailnetId,proto3" json:"tailnet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineSharesRequest) Reset() {
+	*x = ListMachineSharesRequest{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineSharesRequest) ProtoMessage() {}
+
+func (x *ListMachineSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMachineSharesRequest) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMachineSharesRequest) GetTailnetId() uint64 {
+	if x != nil {
+		return x.TailnetId
+	}
+	return 0
+}
+
+type ListMachineSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*MachineShare        `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineSharesResponse) Reset() {
+	*x = ListMachineSharesResponse{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineSharesResponse) ProtoMessage() {}
+
+func (x *ListMachineSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMachineSharesResponse) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMachineSharesResponse) GetShares() []*MachineShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type AcceptMachineShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareId       uint64                 `protobuf:"varint,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptMachineShareRequest) Reset() {
+	*x = AcceptMachineShareRequest{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptMachineShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMachineShareRequest) ProtoMessage() {}
+
+func (x *AcceptMachineShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMachineShareRequest.ProtoReflect.Descriptor instead.
+func (*AcceptMachineShareRequest) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptMachineShareRequest) GetShareId() uint64 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
+type AcceptMachineShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptMachineShareResponse) Reset() {
+	*x = AcceptMachineShareResponse{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptMachineShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMachineShareResponse) ProtoMessage() {}
+
+func (x *AcceptMachineShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMachineShareResponse.ProtoReflect.Descriptor instead.
+func (*AcceptMachineShareResponse) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{5}
+}
+
+type RevokeMachineShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareId       uint64                 `protobuf:"varint,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMachineShareRequest) Reset() {
+	*x = RevokeMachineShareRequest{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMachineShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMachineShareRequest) ProtoMessage() {}
+
+func (x *RevokeMachineShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMachineShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMachineShareRequest) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeMachineShareRequest) GetShareId() uint64 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
+type RevokeMachineShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMachineShareResponse) Reset() {
+	*x = RevokeMachineShareResponse{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMachineShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMachineShareResponse) ProtoMessage() {}
+
+func (x *RevokeMachineShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMachineShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokeMachineShareResponse) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{7}
+}
+
+type MachineShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Machine       *Ref                   `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`
+	SourceTailnet *Ref                   `protobuf:"bytes,3,opt,name=source_tailnet,json=sourceTailnet,proto3" json:"source_tailnet,omitempty"`
+	Tailnet       *Ref                   `protobuf:"bytes,4,opt,name=tailnet,proto3" json:"tailnet,omitempty"`
+	Accepted      bool                   `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineShare) Reset() {
+	*x = MachineShare{}
+	mi := &file_ionscale_v1_shares_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineShare) ProtoMessage() {}
+
+func (x *MachineShare) ProtoReflect() protoreflect.Message {
+	mi := &file_ionscale_v1_shares_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineShare.ProtoReflect.Descriptor instead.
+func (*MachineShare) Descriptor() ([]byte, []int) {
+	return file_ionscale_v1_shares_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MachineShare) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MachineShare) GetMachine() *Ref {
+	if x != nil {
+		return x.Machine
+	}
+	return nil
+}
+
+func (x *MachineShare) GetSourceTailnet() *Ref {
+	if x != nil {
+		return x.SourceTailnet
+	}
+	return nil
+}
+
+func (x *MachineShare) GetTailnet() *Ref {
+	if x != nil {
+		return x.Tailnet
+	}
+	return nil
+}
+
+func (x *MachineShare) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *MachineShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_ionscale_v1_shares_proto protoreflect.FileDescriptor
+
+var file_ionscale_v1_shares_proto_rawDesc = string([]byte{
+	0x0a, 0x18, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x69, 0x6f, 0x6e, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x4e, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65, 0x74, 0x22,
+	0x47, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x39, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x86, 0x02, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x66, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61,
+	0x69, 0x6c, 0x6e, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x07, 0x74, 0x61, 0x69, 0x6c, 0x6e, 0x65,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x69, 0x65, 0x62, 0x65, 0x6e, 0x73, 0x2f,
+	0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x69, 0x6f, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6f, 0x6e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_ionscale_v1_shares_proto_rawDescOnce sync.Once
+	file_ionscale_v1_shares_proto_rawDescData []byte
+)
+
+func file_ionscale_v1_shares_proto_rawDescGZIP() []byte {
+	file_ionscale_v1_shares_proto_rawDescOnce.Do(func() {
+		file_ionscale_v1_shares_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ionscale_v1_shares_proto_rawDesc), len(file_ionscale_v1_shares_proto_rawDesc)))
+	})
+	return file_ionscale_v1_shares_proto_rawDescData
+}
+
+var file_ionscale_v1_shares_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ionscale_v1_shares_proto_goTypes = []any{
+	(*ShareMachineRequest)(nil),        // 0: ionscale.v1.ShareMachineRequest
+	(*ShareMachineResponse)(nil),       // 1: ionscale.v1.ShareMachineResponse
+	(*ListMachineSharesRequest)(nil),   // 2: ionscale.v1.ListMachineSharesRequest
+	(*ListMachineSharesResponse)(nil),  // 3: ionscale.v1.ListMachineSharesResponse
+	(*AcceptMachineShareRequest)(nil),  // 4: ionscale.v1.AcceptMachineShareRequest
+	(*AcceptMachineShareResponse)(nil), // 5: ionscale.v1.AcceptMachineShareResponse
+	(*RevokeMachineShareRequest)(nil),  // 6: ionscale.v1.RevokeMachineShareRequest
+	(*RevokeMachineShareResponse)(nil), // 7: ionscale.v1.RevokeMachineShareResponse
+	(*MachineShare)(nil),               // 8: ionscale.v1.MachineShare
+	(*Ref)(nil),                        // 9: ionscale.v1.Ref
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+}
+var file_ionscale_v1_shares_proto_depIdxs = []int32{
+	8,  // 0: ionscale.v1.ShareMachineResponse.share:type_name -> ionscale.v1.MachineShare
+	8,  // 1: ionscale.v1.ListMachineSharesResponse.shares:type_name -> ionscale.v1.MachineShare
+	9,  // 2: ionscale.v1.MachineShare.machine:type_name -> ionscale.v1.Ref
+	9,  // 3: ionscale.v1.MachineShare.source_tailnet:type_name -> ionscale.v1.Ref
+	9,  // 4: ionscale.v1.MachineShare.tailnet:type_name -> ionscale.v1.Ref
+	10, // 5: ionscale.v1.MachineShare.created_at:type_name -> google.protobuf.Timestamp
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_ionscale_v1_shares_proto_init() }
+func file_ionscale_v1_shares_proto_init() {
+	if File_ionscale_v1_shares_proto != nil {
+		return
+	}
+	file_ionscale_v1_ref_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ionscale_v1_shares_proto_rawDesc), len(file_ionscale_v1_shares_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ionscale_v1_shares_proto_goTypes,
+		DependencyIndexes: file_ionscale_v1_shares_proto_depIdxs,
+		MessageInfos:      file_ionscale_v1_shares_proto_msgTypes,
+	}.Build()
+	File_ionscale_v1_shares_proto = out.File
+	file_ionscale_v1_shares_proto_goTypes = nil
+	file_ionscale_v1_shares_proto_depIdxs = nil
+}